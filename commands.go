@@ -0,0 +1,312 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"vpet/internal/pet"
+	"vpet/internal/ui"
+)
+
+// runCommand runs a subcommand such as "vpet memorial". Returns false if args name no subcommand.
+func runCommand(args []string) bool {
+	if len(args) == 0 {
+		return false
+	}
+
+	switch args[0] {
+	case "memorial":
+		printText(ui.FormatMemorial(pet.LoadMemorial(), pet.LoadLocation()))
+	case "achievements":
+		printText(ui.FormatAchievements(pet.LoadAchievements(), pet.LoadLocation()))
+	case "quests":
+		runQuests()
+	case "wallet":
+		runWallet()
+	case "feed":
+		runFeed(args[1:])
+	case "timezone":
+		runTimeZone(args[1:])
+	case "vacation":
+		runVacation(args[1:])
+	case "quiet":
+		runQuiet(args[1:])
+	case "profile":
+		runProfile(args[1:])
+	case "theme":
+		runTheme(args[1:])
+	default:
+		return false
+	}
+	return true
+}
+
+// printf, printLine and printText write CLI output through the rendering profile
+func printf(format string, args ...interface{}) {
+	fmt.Print(pet.RenderText(fmt.Sprintf(format, args...)))
+}
+
+func printLine(args ...interface{}) {
+	fmt.Print(pet.RenderText(fmt.Sprintln(args...)))
+}
+
+func printText(s string) {
+	fmt.Print(pet.RenderText(s))
+}
+
+// runProfile shows or saves the rendering profile, e.g. "vpet profile ascii". "auto" goes back to detecting it.
+func runProfile(args []string) {
+	settings := pet.LoadSettings()
+	if len(args) == 0 {
+		current := settings.Profile
+		if current == "" {
+			current = "auto"
+		}
+		printf("Rendering profile: %s\n", current)
+		return
+	}
+
+	name := args[0]
+	if name == "auto" {
+		name = ""
+	} else if err := pet.SetProfile(name); err != nil {
+		printLine(err)
+		os.Exit(1)
+	}
+	settings.Profile = name
+	if err := pet.SaveSettings(settings); err != nil {
+		printLine(err)
+		os.Exit(1)
+	}
+	printf("Rendering profile set to %s\n", args[0])
+}
+
+// runFeed feeds the pet from the command line, e.g. "vpet feed --food treat"
+func runFeed(args []string) {
+	var foodTypes []string
+	for _, food := range pet.GetFoodDefinitions() {
+		foodTypes = append(foodTypes, food.Type)
+	}
+
+	fs := flag.NewFlagSet("feed", flag.ExitOnError)
+	food := fs.String("food", pet.FoodKibble, "Food to give ("+strings.Join(foodTypes, ", ")+")")
+	fs.Parse(args)
+
+	p := pet.LoadState()
+	if p.Dead {
+		printf("%s %s has passed away...\n", pet.StatusEmojiDead, p.Name)
+		os.Exit(1)
+	}
+
+	message, fed := p.Feed(*food)
+	pet.SaveState(&p)
+	printLine(message)
+	if !fed {
+		os.Exit(1)
+	}
+}
+
+// runQuests lists today's quests and the current streak
+func runQuests() {
+	p := pet.LoadState()
+	pet.SaveState(&p)
+	if p.Dead {
+		printf("%s %s has passed away...\n", pet.StatusEmojiDead, p.Name)
+		os.Exit(1)
+	}
+
+	printf("🎯 Today's quests for %s\n\n", p.Name)
+	for _, line := range pet.GetQuestLines(p) {
+		printLine(line)
+	}
+	printf("\n🔥 Streak: %d days (best %d)\n", p.CurrentQuestStreak(pet.TimeNow()), p.BestQuestStreak)
+}
+
+// runWallet banks any coins the pet has earned, then shows the balance and recent transactions
+func runWallet() {
+	p := pet.LoadState()
+	pet.SaveState(&p)
+	printText(ui.FormatWallet(pet.LoadWallet(), p.Location()))
+}
+
+// runTimeZone shows or changes the pet's time zone, e.g. "vpet timezone Europe/Berlin"
+func runTimeZone(args []string) {
+	p := pet.LoadState()
+	if len(args) == 0 {
+		printf("🕐 %s's clock: %s\n", p.Name, pet.GetTimeZoneDisplay(p))
+		return
+	}
+
+	// LoadState has already settled elapsed time on the old clock, so the move only affects what comes next
+	message, err := p.SetTimeZone(args[0])
+	if err != nil {
+		printLine(err)
+		os.Exit(1)
+	}
+	pet.SaveState(&p)
+	printLine(message)
+}
+
+// runVacation manages vacation mode, e.g. "vpet vacation start --until 2024-06-01"
+func runVacation(args []string) {
+	p := pet.LoadState()
+	if p.Dead {
+		printf("%s %s has passed away...\n", pet.StatusEmojiDead, p.Name)
+		os.Exit(1)
+	}
+
+	action := "status"
+	if len(args) > 0 {
+		action = args[0]
+	}
+
+	switch action {
+	case "start":
+		fs := flag.NewFlagSet("vacation start", flag.ExitOnError)
+		until := fs.String("until", "", "Last day away (YYYY-MM-DD)")
+		fs.Parse(args[1:])
+
+		returnDate, err := pet.ParseReturnDate(*until, p.Location())
+		if err != nil {
+			printLine("Please give a return date with --until YYYY-MM-DD")
+			os.Exit(1)
+		}
+		message, err := p.StartVacation(returnDate)
+		if err != nil {
+			printLine(err)
+			os.Exit(1)
+		}
+		pet.SaveState(&p)
+		printLine(message)
+	case "end":
+		p.EndVacation(pet.TimeNow())
+		summary := p.TakeVacationSummary()
+		if summary == "" {
+			printf("%s isn't on vacation\n", p.Name)
+			os.Exit(1)
+		}
+		pet.SaveState(&p)
+		printLine(summary)
+	case "status":
+		if display := pet.GetVacationDisplay(p); display != "" {
+			printLine(display)
+		} else {
+			printf("%s is at home\n", p.Name)
+		}
+	default:
+		printLine("Usage: vpet vacation [start --until YYYY-MM-DD | end | status]")
+		os.Exit(1)
+	}
+}
+
+// runQuiet manages quiet hours, e.g. "vpet quiet add -days mon,tue 09:00-17:00" or "vpet quiet dnd 2h"
+func runQuiet(args []string) {
+	q := pet.LoadQuietHours()
+	loc := pet.LoadLocation()
+
+	action := "show"
+	if len(args) > 0 {
+		action = args[0]
+	}
+
+	switch action {
+	case "show":
+		printLine(pet.GetQuietHoursDisplay(q, loc))
+		return
+	case "add":
+		fs := flag.NewFlagSet("quiet add", flag.ExitOnError)
+		days := fs.String("days", "", "Comma-separated days (mon..sun), default every day")
+		fs.Parse(args[1:])
+		block, err := pet.NewQuietBlock(fs.Arg(0), *days)
+		if err != nil {
+			printLine(err)
+			os.Exit(1)
+		}
+		q.Blocks = append(q.Blocks, block)
+		printf("%s Added quiet hours %s\n", pet.StatusEmojiQuiet, block)
+	case "remove":
+		var index int
+		if len(args) < 2 {
+			printLine("Usage: vpet quiet remove N")
+			os.Exit(1)
+		}
+		if _, err := fmt.Sscan(args[1], &index); err != nil || index < 1 || index > len(q.Blocks) {
+			printf("No quiet block %s\n", args[1])
+			os.Exit(1)
+		}
+		printf("Removed quiet hours %s\n", q.Blocks[index-1])
+		q.Blocks = append(q.Blocks[:index-1], q.Blocks[index:]...)
+	case "clear":
+		q = pet.QuietHours{}
+		printLine("Cleared all quiet hours")
+	case "dnd":
+		if len(args) < 2 {
+			printLine("Usage: vpet quiet dnd DURATION|off")
+			os.Exit(1)
+		}
+		if args[1] == "off" {
+			q.DND = nil
+			printLine("🔔 Do not disturb is off")
+			break
+		}
+		duration, err := time.ParseDuration(args[1])
+		if err != nil || duration <= 0 || duration > pet.MaxQuietHoursPerDay*time.Hour {
+			printf("Do not disturb needs a duration up to %dh, e.g. 90m\n", pet.MaxQuietHoursPerDay)
+			os.Exit(1)
+		}
+		now := pet.TimeNow()
+		q.DND = &pet.TimeRange{Start: now, End: now.Add(duration)}
+		printf("%s Do not disturb until %s\n", pet.StatusEmojiQuiet, q.DND.End.In(loc).Format("15:04"))
+	case "calendar":
+		if len(args) < 2 {
+			printLine("Usage: vpet quiet calendar PATH|off")
+			os.Exit(1)
+		}
+		if args[1] == "off" {
+			q.Calendar = ""
+			printLine("Calendar disconnected")
+			break
+		}
+		busy, err := pet.LoadCalendarBusy(args[1], loc)
+		if err != nil {
+			printf("Can't read calendar: %v\n", err)
+			os.Exit(1)
+		}
+		q.Calendar = args[1]
+		printf("📅 Using %d busy blocks from %s\n", len(busy), args[1])
+	default:
+		printLine("Usage: vpet quiet [show | add [-days mon,...] HH:MM-HH:MM | remove N | clear | dnd DURATION|off | calendar PATH|off]")
+		os.Exit(1)
+	}
+	pet.SaveQuietHours(q)
+}
+
+// runTheme lists the themes or saves one, e.g. "vpet theme high-contrast"
+func runTheme(args []string) {
+	settings := pet.LoadSettings()
+	if len(args) == 0 {
+		current := settings.Theme
+		if current == "" {
+			current = pet.ThemeAuto
+		}
+		printf("Theme: %s\n\nAvailable: %s\nUser themes go in %s\n", current, strings.Join(pet.GetThemeNames(), ", "), pet.GetThemesDir())
+		return
+	}
+
+	if _, err := pet.LoadTheme(args[0], true); err != nil {
+		printLine(err)
+		os.Exit(1)
+	}
+	settings.Theme = args[0]
+	if args[0] == pet.ThemeAuto {
+		settings.Theme = ""
+	}
+	if err := pet.SaveSettings(settings); err != nil {
+		printLine(err)
+		os.Exit(1)
+	}
+	printf("Theme set to %s\n", args[0])
+}
@@ -1,6 +1,7 @@
 package chase
 
 import (
+	"fmt"
 	"log"
 	"math"
 	"math/rand"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/mattn/go-runewidth"
 
 	"vpet/internal/pet"
@@ -26,6 +28,35 @@ const (
 	petSpeed           = 10.0 // pet moves 10 columns/second
 )
 
+// chaseStyles color the chase field, built from a theme
+type chaseStyles struct {
+	field  lipgloss.Style // The pet and everything else on the field
+	target lipgloss.Style
+	hint   lipgloss.Style
+}
+
+var styles = newStyles(*pet.GetBuiltinTheme(pet.ThemeDark))
+
+// ApplyTheme restyles chase mode
+func ApplyTheme(theme pet.Theme) {
+	styles = newStyles(theme)
+}
+
+func newStyles(theme pet.Theme) chaseStyles {
+	themed := func(color string) lipgloss.Style {
+		style := lipgloss.NewStyle().Bold(theme.Bold)
+		if color != "" {
+			style = style.Foreground(lipgloss.Color(color))
+		}
+		return style
+	}
+	return chaseStyles{
+		field:  themed(theme.Primary),
+		target: themed(theme.Accent),
+		hint:   themed(theme.Border),
+	}
+}
+
 // RNG is the seeded random number generator for chase mode
 // Exposed for testing and future features (pickups, boss targets, etc.)
 var RNG *rand.Rand
@@ -95,6 +126,7 @@ type Model struct {
 	TargetPosY     float64
 	LastUpdateTime time.Time
 	ElapsedTime    float64 // Total elapsed time in seconds
+	Caught         bool    // The pet caught the target before the run ended
 }
 
 type animTickMsg time.Time
@@ -152,10 +184,23 @@ func Run(seed int64) {
 	model := newModelWithPet(p, rng)
 
 	program := tea.NewProgram(model, tea.WithAltScreen())
-	if _, err := program.Run(); err != nil {
+	final, err := program.Run()
+	if err != nil {
 		log.Printf("Chase animation error: %v", err)
 		os.Exit(1)
 	}
+
+	if m, ok := final.(Model); ok && m.Caught && !p.Dead {
+		reward, earned := pet.RewardChaseCatch(&p, m.Target.Name)
+		if reward == 0 {
+			fmt.Print(pet.RenderText(fmt.Sprintf("Caught the %s! No more chase rewards today.\n", m.Target.Name)))
+			return
+		}
+		fmt.Print(pet.RenderText(fmt.Sprintf("%s +%d for catching the %s!\n", pet.CoinEmoji, reward, m.Target.Name)))
+		for _, def := range earned {
+			fmt.Print(pet.RenderText(fmt.Sprintf("🏆 Achievement unlocked: %s %s\n", def.Emoji, def.Name)))
+		}
+	}
 }
 
 func tick() tea.Cmd {
@@ -238,6 +283,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		// Catch condition: overlapping X and same row
 		if math.Abs(m.TargetPosX-m.PetPosX) <= 1 && int(m.TargetPosY) == int(m.PetPosY) {
+			m.Caught = true
 			return m, tea.Quit
 		}
 
@@ -258,19 +304,21 @@ func (m Model) View() string {
 	// Calculate distance to determine emoji
 	distX := int(m.TargetPosX - m.PetPosX)
 	distY := int(m.TargetPosY - m.PetPosY)
-	petEmoji := getChaseEmoji(m.Pet, distX, distY)
+	petEmoji := pet.RenderText(getChaseEmoji(m.Pet, distX, distY))
 
-	// Build 2D grid for animation
+	// Build 2D grid for animation, marking the cells that belong to the target
 	grid := make([][]rune, rows-1)
+	isTarget := make([][]bool, rows-1)
 	for y := 0; y < rows-1; y++ {
 		grid[y] = make([]rune, m.TermWidth)
+		isTarget[y] = make([]bool, m.TermWidth)
 		for x := 0; x < m.TermWidth; x++ {
 			grid[y][x] = ' '
 		}
 	}
 
 	// Helper function to place emoji with proper width handling
-	placeEmoji := func(emoji string, x, y int) {
+	placeEmoji := func(emoji string, x, y int, target bool) {
 		if y < 0 || y >= rows-1 || x < 0 {
 			return
 		}
@@ -282,24 +330,46 @@ func (m Model) View() string {
 				break
 			}
 			grid[y][col] = r
+			isTarget[y][col] = target
 			col += width
 		}
 	}
 
+	// Place the equipped cosmetic on the row above the pet, or beside it on the top row, before the
+	// target so it never hides it
+	if cosmetic := m.Pet.GetCosmeticEmoji(); cosmetic != "" {
+		x, y := int(m.PetPosX), int(m.PetPosY)-1
+		if y < 0 {
+			x, y = x+pet.DisplayWidth(petEmoji), 0
+		}
+		placeEmoji(cosmetic, x, y, false)
+	}
+
 	// Place target at its 2D position (convert float to int for rendering)
-	placeEmoji(m.Target.Emoji, int(m.TargetPosX), int(m.TargetPosY))
+	placeEmoji(pet.RenderText(m.Target.Emoji), int(m.TargetPosX), int(m.TargetPosY), true)
 
 	// Place pet at its 2D position
-	placeEmoji(petEmoji, int(m.PetPosX), int(m.PetPosY))
+	placeEmoji(petEmoji, int(m.PetPosX), int(m.PetPosY), false)
 
-	// Convert grid to string
+	// Convert grid to string, styling each run of target or field cells
 	var result strings.Builder
 	for y := 0; y < rows-1; y++ {
-		result.WriteString(string(grid[y]))
+		start := 0
+		for x := 1; x <= m.TermWidth; x++ {
+			if x < m.TermWidth && isTarget[y][x] == isTarget[y][start] {
+				continue
+			}
+			style := styles.field
+			if isTarget[y][start] {
+				style = styles.target
+			}
+			result.WriteString(style.Render(string(grid[y][start:x])))
+			start = x
+		}
 		result.WriteRune('\n')
 	}
 
-	result.WriteString("\nPress any key to exit")
+	result.WriteString("\n" + styles.hint.Render("Press any key to exit"))
 
 	return result.String()
 }
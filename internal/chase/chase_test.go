@@ -365,6 +365,26 @@ func TestModel_Update_AnimTick_CatchEndsRun(t *testing.T) {
 	}
 }
 
+func TestModel_Update_AnimTick_CatchIsRecorded(t *testing.T) {
+	baseTime := time.Now()
+	m := Model{
+		Pet:            pet.Pet{},
+		Target:         Target{Emoji: "🦋", Name: "still", Speed: 0},
+		TermWidth:      40,
+		TermHeight:     10,
+		LastUpdateTime: baseTime,
+		PetPosX:        10,
+		TargetPosX:     10,
+		TargetPhase:    -10 * 0.2, // sin(0): target sits on the center row
+	}
+	m.PetPosY = float64(m.visibleRows()) / 2
+
+	updated, _ := m.Update(animTickMsg(baseTime.Add(70 * time.Millisecond)))
+	if !updated.(Model).Caught {
+		t.Errorf("expected catch to be recorded when pet reaches target")
+	}
+}
+
 func TestModel_Update_AnimTick_BoundaryConstraints(t *testing.T) {
 	// Test that target stays within boundaries during sine wave movement
 	t.Run("Target stays within vertical boundaries", func(t *testing.T) {
@@ -495,6 +515,55 @@ func TestModel_View_ContainsPetAndTarget(t *testing.T) {
 	}
 }
 
+func TestModel_View_CosmeticAbovePet(t *testing.T) {
+	m := Model{
+		Pet:        pet.Pet{Cosmetic: pet.ShopTopHat},
+		Target:     Targets["butterfly"],
+		TermWidth:  80,
+		TermHeight: 24,
+		PetPosX:    5,
+		PetPosY:    10,
+		TargetPosX: 30,
+		TargetPosY: 3,
+	}
+
+	lines := strings.Split(m.View(), "\n")
+	if !strings.Contains(lines[9], "🎩") {
+		t.Errorf("Expected the top hat on the row above the pet, got %q", lines[9])
+	}
+
+	m.PetPosY = 0
+	if lines := strings.Split(m.View(), "\n"); !strings.Contains(lines[0], "🎩") {
+		t.Errorf("Expected the top hat beside the pet on the top row, got %q", lines[0])
+	}
+}
+
+func TestModel_View_ASCIIProfile(t *testing.T) {
+	pet.SetProfile(pet.ProfileASCII)
+	defer pet.SetProfile(pet.ProfileEmoji)
+
+	m := Model{
+		Pet:        pet.Pet{Energy: 50, Happiness: 50, Hunger: 50},
+		Target:     Targets["butterfly"],
+		TermWidth:  80,
+		TermHeight: 24,
+		PetPosX:    5,
+		PetPosY:    10,
+		TargetPosX: 30,
+		TargetPosY: 10,
+	}
+
+	view := m.View()
+	if !strings.Contains(view, "}{") || !strings.Contains(view, ":)") {
+		t.Errorf("Expected ASCII pet and target, got:\n%s", view)
+	}
+	for _, r := range view {
+		if r > 127 {
+			t.Fatalf("Expected a plain ASCII chase, found %q", r)
+		}
+	}
+}
+
 func TestModel_View_GridDimensions(t *testing.T) {
 	m := Model{
 		Pet:        pet.Pet{},
@@ -0,0 +1,218 @@
+package pet
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// AchievementDefinition describes a milestone that can be unlocked once across all pets
+type AchievementDefinition struct {
+	ID          string
+	Name        string
+	Emoji       string
+	Description string
+	Check       func(p *Pet, a Achievements) bool
+}
+
+// UnlockedAchievement records when an achievement was earned and by which pet
+type UnlockedAchievement struct {
+	ID        string    `json:"id"`
+	Time      time.Time `json:"time"`
+	PetName   string    `json:"pet_name,omitempty"`
+	Announced bool      `json:"announced,omitempty"` // Shown in the TUI
+}
+
+// Achievements holds everything earned across pets, stored apart from the pet itself
+type Achievements struct {
+	Unlocked     []UnlockedAchievement `json:"unlocked,omitempty"`
+	ChaseCatches int                   `json:"chase_catches,omitempty"`
+}
+
+// Achievement IDs
+const (
+	AchievementFirstEvolution = "first_evolution"
+	AchievementEliteAdult     = "elite_adult"
+	AchievementWeekSurvivor   = "week_survivor"
+	AchievementAttentive      = "attentive"
+	AchievementSoulmates      = "soulmates"
+	AchievementPerfectDay     = "perfect_day"
+	AchievementFirstCatch     = "first_catch"
+	AchievementMasterHunter   = "master_hunter"
+)
+
+// GetAchievementDefinitions returns every achievement in display order
+func GetAchievementDefinitions() []AchievementDefinition {
+	return []AchievementDefinition{
+		{
+			ID: AchievementFirstEvolution, Name: "First Steps", Emoji: "🐣",
+			Description: "Evolve for the first time",
+			Check:       func(p *Pet, a Achievements) bool { return len(p.EvolutionHistory) > 0 },
+		},
+		{
+			ID: AchievementEliteAdult, Name: "Elite", Emoji: "⭐",
+			Description: "Raise an Elite Adult",
+			Check: func(p *Pet, a Achievements) bool {
+				for _, record := range p.EvolutionHistory {
+					if record.To == FormEliteAdult {
+						return true
+					}
+				}
+				return p.Form == FormEliteAdult
+			},
+		},
+		{
+			ID: AchievementWeekSurvivor, Name: "Survivor", Emoji: "📅",
+			Description: "Keep a pet alive for 7 days",
+			Check:       func(p *Pet, a Achievements) bool { return p.Age >= WeekSurvivorAge },
+		},
+		{
+			ID: AchievementAttentive, Name: "Attentive Owner", Emoji: "🔔",
+			Description: "Respond to 50 events with one pet",
+			Check:       func(p *Pet, a Achievements) bool { return p.EventsResponded >= AttentiveEventCount },
+		},
+		{
+			ID: AchievementSoulmates, Name: "Soulmates", Emoji: "💕",
+			Description: "Reach a Soulmates bond",
+			Check:       func(p *Pet, a Achievements) bool { return p.Bond >= SoulmatesBond },
+		},
+		{
+			ID: AchievementPerfectDay, Name: "Perfect Day", Emoji: "☀️",
+			Description: "Go a whole day without ignoring an event",
+			Check:       func(p *Pet, a Achievements) bool { return p.HadPerfectDay(TimeNow()) },
+		},
+		{
+			ID: AchievementFirstCatch, Name: "Gotcha!", Emoji: "🦋",
+			Description: "Catch something in chase mode",
+			Check:       func(p *Pet, a Achievements) bool { return a.ChaseCatches >= 1 },
+		},
+		{
+			ID: AchievementMasterHunter, Name: "Master Hunter", Emoji: "🏹",
+			Description: "Make 10 catches in chase mode",
+			Check:       func(p *Pet, a Achievements) bool { return a.ChaseCatches >= MasterHunterCatches },
+		},
+	}
+}
+
+// GetAchievementDefinition returns the definition for an achievement, or nil if unknown
+func GetAchievementDefinition(id string) *AchievementDefinition {
+	for _, def := range GetAchievementDefinitions() {
+		if def.ID == id {
+			return &def
+		}
+	}
+	return nil
+}
+
+// HadPerfectDay reports whether the pet has been around a full day and responded to
+// every event in the last one
+func (p *Pet) HadPerfectDay(now time.Time) bool {
+	if p.Age < 24 {
+		return false
+	}
+	responded := 0
+	for _, entry := range p.EventLog {
+		if now.Sub(entry.Time) > 24*time.Hour {
+			continue
+		}
+		if entry.WasIgnored {
+			return false
+		}
+		responded++
+	}
+	return responded >= PerfectDayMinEvents
+}
+
+// GetAchievementsPath returns the path to the achievements file, next to the pet state file
+func GetAchievementsPath() string {
+	return filepath.Join(filepath.Dir(GetConfigPath()), "achievements.json")
+}
+
+// LoadAchievements loads the achievements, returning an empty set if none exists
+func LoadAchievements() Achievements {
+	var a Achievements
+	data, err := os.ReadFile(GetAchievementsPath())
+	if err != nil {
+		return a
+	}
+	if err := json.Unmarshal(data, &a); err != nil {
+		log.Printf("Error loading achievements: %v", err)
+	}
+	return a
+}
+
+// SaveAchievements writes the achievements to disk
+func SaveAchievements(a Achievements) {
+	data, err := json.MarshalIndent(a, "", "  ")
+	if err != nil {
+		log.Printf("Error saving achievements: %v", err)
+		return
+	}
+	if err := os.WriteFile(GetAchievementsPath(), data, 0644); err != nil {
+		log.Printf("Error writing achievements: %v", err)
+	}
+}
+
+// Has reports whether an achievement has been unlocked
+func (a Achievements) Has(id string) bool {
+	for _, unlocked := range a.Unlocked {
+		if unlocked.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// unlock records every achievement the pet now qualifies for. Returns the new ones.
+func (a *Achievements) unlock(p *Pet) []AchievementDefinition {
+	var earned []AchievementDefinition
+	for _, def := range GetAchievementDefinitions() {
+		if a.Has(def.ID) || !def.Check(p, *a) {
+			continue
+		}
+		a.Unlocked = append(a.Unlocked, UnlockedAchievement{ID: def.ID, Time: TimeNow(), PetName: p.Name})
+		earned = append(earned, def)
+		log.Printf("Achievement unlocked: %s %s", def.Emoji, def.Name)
+	}
+	return earned
+}
+
+// CheckAchievements unlocks any achievements the pet has earned. Returns the new ones.
+func CheckAchievements(p *Pet) []AchievementDefinition {
+	a := LoadAchievements()
+	earned := a.unlock(p)
+	if len(earned) > 0 {
+		SaveAchievements(a)
+	}
+	return earned
+}
+
+// RecordChaseCatch counts a target caught in chase mode. Returns any achievements it unlocked.
+func RecordChaseCatch(p *Pet) []AchievementDefinition {
+	a := LoadAchievements()
+	a.ChaseCatches++
+	earned := a.unlock(p)
+	SaveAchievements(a)
+	return earned
+}
+
+// TakeUnannouncedAchievements returns achievements not yet shown in the TUI and marks them shown
+func TakeUnannouncedAchievements() []AchievementDefinition {
+	a := LoadAchievements()
+	var pending []AchievementDefinition
+	for i := range a.Unlocked {
+		if a.Unlocked[i].Announced {
+			continue
+		}
+		a.Unlocked[i].Announced = true
+		if def := GetAchievementDefinition(a.Unlocked[i].ID); def != nil {
+			pending = append(pending, *def)
+		}
+	}
+	if len(pending) > 0 {
+		SaveAchievements(a)
+	}
+	return pending
+}
@@ -0,0 +1,99 @@
+package pet
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+)
+
+// LoadCalendarBusy reads the busy events from a local ICS file. Only single events with a
+// start and end time count; all-day, free (transparent) and cancelled events are skipped,
+// and recurrence rules aren't expanded.
+func LoadCalendarBusy(path string, loc *time.Location) ([]TimeRange, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	// Unfold continuation lines, which start with a space or tab
+	var lines []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimRight(scanner.Text(), "\r")
+		if len(lines) > 0 && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		lines = append(lines, line)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	var busy []TimeRange
+	var start, end time.Time
+	var skip, inEvent bool
+	for _, line := range lines {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		property, params, _ := strings.Cut(name, ";")
+		switch {
+		case line == "BEGIN:VEVENT":
+			inEvent, skip = true, false
+			start, end = time.Time{}, time.Time{}
+		case line == "END:VEVENT":
+			if inEvent && !skip && !start.IsZero() && end.After(start) {
+				busy = append(busy, TimeRange{Start: start, End: end})
+			}
+			inEvent = false
+		case !inEvent:
+		case property == "DTSTART" || property == "DTEND":
+			t, allDay, err := parseCalendarTime(value, params, loc)
+			if err != nil || allDay {
+				skip = true
+				continue
+			}
+			if property == "DTSTART" {
+				start = t
+			} else {
+				end = t
+			}
+		case property == "TRANSP" && value == "TRANSPARENT", property == "STATUS" && value == "CANCELLED":
+			skip = true
+		}
+	}
+	return busy, nil
+}
+
+// parseCalendarTime parses an ICS date-time value, honoring UTC and TZID. Floating times use loc.
+// Reports whether the value was a date, meaning an all-day event.
+func parseCalendarTime(value, params string, loc *time.Location) (time.Time, bool, error) {
+	for _, param := range strings.Split(params, ";") {
+		key, val, _ := strings.Cut(param, "=")
+		switch {
+		case key == "VALUE" && val == "DATE":
+			return time.Time{}, true, nil
+		case key == "TZID":
+			if tz, err := time.LoadLocation(val); err == nil {
+				loc = tz
+			}
+		}
+	}
+	if strings.HasSuffix(value, "Z") {
+		t, err := time.Parse("20060102T150405Z", value)
+		return t, false, err
+	}
+	if len(value) == len("20060102") {
+		return time.Time{}, true, nil
+	}
+	t, err := time.ParseInLocation("20060102T150405", value, loc)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid calendar time %q", value)
+	}
+	return t, false, nil
+}
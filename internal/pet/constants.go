@@ -10,7 +10,6 @@ const (
 	LowStatThreshold   = 30
 	DeathTimeThreshold = 12 * time.Hour // Time in critical state before death
 	HealthDecreaseRate = 2              // Health loss per hour
-	AgeStageThresholds = 48             // Hours per life stage
 	IllnessChance      = 0.1            // 10% chance per hour when health <50
 	MedicineEffect     = 30             // Health restored by medicine
 	MinNaturalLifespan = 168            // Hours before natural death possible (~1 week)
@@ -28,6 +27,20 @@ const (
 	PlayEnergyDecrease    = 10
 	PlayHungerDecrease    = 5
 
+	// Life stage ages (hours since birth)
+	ChildStageAge  = 48
+	TeenStageAge   = 72
+	AdultStageAge  = 96
+	SeniorStageAge = 144
+
+	// Evolution branch requirements
+	MinEventsForResponseRate = 5  // Logged events needed before response rate counts
+	HighResponseRate         = 80 // % of events responded to for devoted branches
+	LowResponseRate          = 30 // % of events responded to below which pets rebel
+	DevotedBondThreshold     = 90 // Bond needed for the Devoted Adult branch
+	PhoenixBondThreshold     = 80 // Bond needed for the secret Phoenix Adult branch
+	LegendBondThreshold      = 95 // Bond needed for the secret Legend Elder branch
+
 	// Care quality thresholds for evolution
 	PerfectCareThreshold = 85
 	GoodCareThreshold    = 70
@@ -43,6 +56,7 @@ const (
 	WantHungerThreshold = 40 // Hunger deficit to show 🍖 want (Hunger <= 60)
 	WantHappyThreshold  = 40 // Happiness deficit to show 🎾 want (Happiness <= 60)
 	WantEnergyThreshold = 55 // Energy deficit to show 🛌 want (Energy <= 45)
+	WantCleanThreshold  = 50 // Cleanliness deficit to show 🧽 want (Cleanliness <= 50)
 	AutoWakeEnergy      = 80 // Energy level to wake up automatically
 	MinSleepDuration    = 6  // Minimum hours of auto-sleep
 	MaxSleepDuration    = 8  // Maximum hours before forced wake
@@ -54,6 +68,44 @@ const (
 	OutsideActiveHappinessMult = 0.7 // 30% less happiness gain outside active hours
 	PreferredSleepRecoveryMult = 1.2 // 20% better sleep recovery during preferred hours
 
+	// Schedule learning
+	ScheduleAdaptInterval   = 24 * time.Hour     // Time between schedule adjustments
+	ScheduleLearningWindow  = 7 * 24 * time.Hour // How far back interactions count toward the owner's pattern
+	ScheduleMinInteractions = 5                  // Interactions needed before the schedule adapts
+	ScheduleMaxDrift        = 1                  // Hours the schedule can shift per adjustment
+
+	// Time zone changes
+	TimeZoneGracePeriod    = 24 * time.Hour // Pet counts as active while adjusting to a new zone
+	TimeZoneChangeCooldown = 72 * time.Hour // Minimum time between time zone changes
+
+	// Vacation mode
+	MaxVacationDuration = 14 * 24 * time.Hour // Longest a pet sitter will take the pet
+	VacationCooldown    = 7 * 24 * time.Hour  // Time home between vacations
+	VacationDecayMult   = 0.25                // Stats decay at a quarter speed with the sitter
+	VacationStatFloor   = 50                  // The sitter keeps every stat at least this high
+	MaxVacationHistory  = 10                  // Finished trips remembered
+
+	// Coin economy (earnings and prices live in the tuning config)
+	MaxTransactionLog = 100  // Wallet transactions kept
+	CoinEmoji         = "🪙" // Shown next to coin amounts
+
+	// Daily quests
+	DailyQuestCount         = 3  // Quests dealt each day
+	QuestHappinessThreshold = 60 // Happiness to stay above for the all-day quest
+	QuestMinCheckpoints     = 4  // Stat checkpoints needed to judge a whole day
+	QuestBathThreshold      = 80 // Cleanliness below which a bath quest is offered
+	QuestStreakBonusDays    = 7  // Every this many streak days earns a bonus
+	QuestStreakBonusBond    = 5  // Bond bonus for a streak milestone
+
+	// Achievements
+	WeekSurvivorAge     = 168 // Hours alive for the Survivor achievement
+	AttentiveEventCount = 50  // Events responded to for Attentive Owner
+	PerfectDayMinEvents = 2   // Events that must be answered in a day for Perfect Day
+	MasterHunterCatches = 10  // Chase catches for Master Hunter
+
+	// Quiet hours
+	MaxQuietHoursPerDay = 12 // Most quiet time counted in a single day
+
 	// Bonding system constants
 	MaxBond               = 100           // Maximum bond level
 	InitialBond           = 50            // Starting bond for new pets
@@ -64,9 +116,81 @@ const (
 	MaxBondMultiplier     = 1.0           // Action effectiveness at 100 bond
 	BondGainWellTimed     = 2             // Bond gained for well-timed action
 	BondGainNormal        = 1             // Bond gained for normal action
+	SoulmatesBond         = 90            // Bond level described as Soulmates
 	IllnessResistanceBond = 70            // Bond level that starts reducing illness chance
 	MaxInteractionHistory = 20            // Keep last 20 interactions
 
+	// Training system constants
+	TrainEnergyCost          = 15  // Energy spent per training session
+	TrainHungerCost          = 5   // Hunger spent per training session
+	TrainMinEnergy           = 30  // Minimum energy required to train
+	TrainBaseSuccessChance   = 0.8 // Chance a session makes progress at full bond
+	TrainProgressGain        = 20  // Base proficiency gained per successful session
+	TrickMasteredThreshold   = 80  // Proficiency at which a trick is mastered
+	TrickCompetentThreshold  = 40  // Proficiency at which a trick is reliable
+	TrickPracticeGain        = 2   // Proficiency gained each time a trick is performed
+	PerformHappinessIncrease = 20  // Happiness from performing a mastered trick
+	PerformEnergyDecrease    = 5   // Energy spent performing a trick
+
+	// Play activity unlocks
+	TugUnlockBond   = 40            // Bond needed for tug of war
+	PuzzleUnlockAge = ChildStageAge // Age in hours needed for the puzzle toy
+	LaserUnlockBond = 60            // Bond needed for the laser pointer
+
+	// Illness constants
+	OverdoseHealthPenalty    = 10  // Health lost from unneeded medicine
+	OverdoseHappinessPenalty = 5   // Happiness lost from unneeded medicine
+	FoundSomethingContagion  = 0.3 // Chance of catching a cold from something the pet found
+
+	// Vet constants
+	CheckupCooldown       = 6 * time.Hour // Time between vet checkups
+	MedicineAbuseWindow   = 6 * time.Hour // Window in which a healthy pet's refused pill makes the next one an overdose
+	MedicineAbuseBondLoss = 2             // Bond lost from overdosing the pet
+
+	// Hygiene constants
+	CleanlinessDecreaseRate = 2  // Cleanliness lost per hour while awake
+	SleepingCleanlinessRate = 1  // Cleanliness lost per hour while sleeping
+	CleanEffect             = 60 // Cleanliness restored by cleaning
+	CleanRefuseThreshold    = 90 // Cleanliness at or above which there's nothing to clean
+	MessyThreshold          = 60 // Cleanliness below which mess shows up
+	DirtyThreshold          = 30 // Cleanliness below which health suffers
+	DirtyHealthDecayRate    = 1  // Health lost per hour in a dirty environment
+	DirtyIllnessMultiplier  = 2  // Illness chance multiplier in a dirty environment
+
+	// Weight constants (weight is the distance from the pet's ideal weight)
+	MinWeight              = -50
+	MaxWeight              = 50
+	UnderweightThreshold   = -20 // Weight at or below which the pet is underweight
+	OverweightThreshold    = 20  // Weight at or above which the pet is overweight
+	OverfeedWeightGain     = 4   // Extra weight from overfeeding
+	FrequentFeedWeightGain = 2   // Extra weight per feed already given within the spam window
+	StarvingWeightLoss     = 1   // Weight lost per hour while hunger is low
+	MetabolismHours        = 8   // Hours per point of weight drifting back toward ideal
+
+	// Food constants
+	OverfeedThreshold     = 75            // Hunger at or above which feeding counts as overfeeding
+	OverfeedHealthPenalty = 5             // Health lost from overfeeding
+	JunkFoodWindow        = 6 * time.Hour // Window for counting recent junk food
+	JunkFoodLimit         = 2             // Junk food servings in the window before health suffers
+	JunkFoodHealthPenalty = 8             // Health lost from too much junk food
+
+	// Trait behavior constants
+	RareTraitWeight      = 0.1 // Roll weight of a rare trait relative to a common one
+	StubbornTrainBond    = 60  // Bond below which Stubborn pets refuse to train
+	GourmetFeedHunger    = 70  // Hunger at or above which Gourmet pets refuse food
+	TimidTrainHappiness  = 40  // Happiness below which Timid pets refuse to train
+	SleepyheadPlayEnergy = 40  // Energy below which Sleepyhead pets refuse to play
+
+	// Trait drift thresholds (experiences needed before a trait takes hold)
+	TimidDriftThreshold       = 3  // Ignored scares
+	HyperactiveDriftThreshold = 25 // Play sessions
+	TrustingDriftThreshold    = 15 // Well-timed feeds, plays and medicine
+
+	// Heredity constants
+	InheritTraitChance      = 0.6 // Chance each of the parent's traits passes to an heir
+	InheritChronotypeChance = 0.5 // Chance an heir keeps the parent's chronotype
+	MaxInheritedBondBonus   = 15  // Starting bond bonus for the heir of a perfectly cared-for pet
+
 	// Status emojis
 	StatusEmojiHappy       = "😸" // Default happy status
 	StatusEmojiNeutral     = "🙂" // Neutral/normal state
@@ -76,8 +200,11 @@ const (
 	StatusEmojiEnergetic   = "😼" // Energetic/fast
 	StatusEmojiExcited     = "😻" // Excited/about to catch
 	StatusEmojiSick        = "🤢" // Sick/ill
+	StatusEmojiDirty       = "💩" // Dirty/messy environment
 	StatusEmojiTired       = "😾" // Tired/grumpy
 	StatusEmojiDead        = "💀" // Dead
+	StatusEmojiVacation    = "🏖️" // Away with a pet sitter
+	StatusEmojiQuiet       = "🔕" // Quiet hours, not demanding attention
 )
 
 // Chronotype constants
@@ -103,4 +230,33 @@ const (
 	FormRedeemedAdult
 	FormDelinquentAdult
 	FormWeakAdult
+	// Teen forms
+	FormHealthyTeen
+	FormTroubledTeen
+	FormSicklyTeen
+	FormProdigyTeen
+	FormRebelTeen
+	// Additional adult forms
+	FormDevotedAdult
+	FormPerformerAdult
+	FormFreeSpiritAdult
+	FormSurvivorAdult
+	FormPhoenixAdult
+	// Senior forms
+	FormWiseElder
+	FormSereneElder
+	FormGoldenElder
+	FormCrankyElder
+	FormFrailElder
+	FormLegendElder
+)
+
+// Life stages. Values are persisted, so later stages are appended rather than
+// renumbered; use GetLifeStageOrder for chronological comparisons.
+const (
+	StageBaby   = 0
+	StageChild  = 1
+	StageAdult  = 2
+	StageTeen   = 3
+	StageSenior = 4
 )
@@ -0,0 +1,71 @@
+package pet
+
+import (
+	"strings"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// Cosmetics are drawn next to the form emoji wherever the pet appears. Terminals disagree with go-runewidth about
+// emoji presentation sequences like "🕶️" (a narrow symbol plus VS16), which runewidth counts as one column but most
+// terminals draw as two. Every cosmetic emoji is therefore measured with DisplayWidth, and fixed-width layouts drop
+// the cosmetic rather than the form emoji when space runs out.
+
+// variationSelectorEmoji is VS16, which asks for the preceding symbol to be drawn as a wide emoji
+const variationSelectorEmoji = '\uFE0F'
+
+// DisplayWidth returns how many terminal columns a string occupies, counting VS16 sequences as wide
+func DisplayWidth(s string) int {
+	width := 0
+	prev := 0
+	for _, r := range s {
+		if r == variationSelectorEmoji {
+			if prev == 1 {
+				width++
+			}
+			prev = 0
+			continue
+		}
+		prev = runewidth.RuneWidth(r)
+		width += prev
+	}
+	return width
+}
+
+// PadDisplay pads a string with spaces to a display width, truncating it if it is wider
+func PadDisplay(s string, width int) string {
+	if w := DisplayWidth(s); w <= width {
+		return s + strings.Repeat(" ", width-w)
+	}
+	var b strings.Builder
+	used := 0
+	for _, r := range s {
+		w := runewidth.RuneWidth(r)
+		if r == variationSelectorEmoji {
+			w = 0
+		}
+		if used+w > width {
+			break
+		}
+		b.WriteRune(r)
+		used += w
+	}
+	return b.String() + strings.Repeat(" ", width-used)
+}
+
+// GetCosmeticEmoji returns the emoji of the equipped cosmetic, or "" if the pet isn't wearing one
+func (p *Pet) GetCosmeticEmoji() string {
+	if p.Cosmetic == "" {
+		return ""
+	}
+	item := GetShopItem(p.Cosmetic)
+	if item == nil || item.Category != ShopCategoryCosmetic {
+		return ""
+	}
+	return RenderText(item.Emoji)
+}
+
+// GetDressedEmoji returns the form emoji with the equipped cosmetic beside it
+func (p *Pet) GetDressedEmoji() string {
+	return p.GetCosmeticEmoji() + p.GetFormEmoji()
+}
@@ -0,0 +1,137 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Experiences that can shift a pet's personality
+const (
+	ExperienceIgnoredScare   = "ignored_" + EventScared
+	ExperienceComfortedScare = "responded_" + EventScared
+	ExperiencePlay           = "play"
+	ExperienceWellTimedCare  = "well_timed_care"
+)
+
+// TraitDriftRule describes how repeated experiences grow a trait
+type TraitDriftRule struct {
+	Trait      string
+	Experience string // Experience that moves the pet toward the trait
+	Counter    string // Experience that moves the pet away from it (optional)
+	Threshold  int    // Experiences needed before the trait takes hold
+	Reason     string
+}
+
+// TraitChange records a trait gained through experience
+type TraitChange struct {
+	Time   time.Time `json:"time"`
+	From   string    `json:"from,omitempty"`
+	To     string    `json:"to"`
+	Reason string    `json:"reason"`
+}
+
+// GetTraitDriftRules returns every way a pet's personality can change
+func GetTraitDriftRules() []TraitDriftRule {
+	return []TraitDriftRule{
+		{
+			Trait:      "Timid",
+			Experience: ExperienceIgnoredScare,
+			Counter:    ExperienceComfortedScare,
+			Threshold:  TimidDriftThreshold,
+			Reason:     "was left alone when scared",
+		},
+		{
+			Trait:      "Hyperactive",
+			Experience: ExperiencePlay,
+			Threshold:  HyperactiveDriftThreshold,
+			Reason:     "played a lot",
+		},
+		{
+			Trait:      "Trusting",
+			Experience: ExperienceWellTimedCare,
+			Threshold:  TrustingDriftThreshold,
+			Reason:     "was cared for when it mattered",
+		},
+	}
+}
+
+// AddTraitExperience records an experience and shifts the pet's traits once
+// enough similar experiences accumulate. Returns the change if one happened.
+func (p *Pet) AddTraitExperience(experience string) *TraitChange {
+	var change *TraitChange
+	for _, rule := range GetTraitDriftRules() {
+		if p.HasTrait(rule.Trait) {
+			continue
+		}
+
+		if rule.Counter == experience && p.TraitProgress[rule.Trait] > 0 {
+			p.TraitProgress[rule.Trait]--
+			continue
+		}
+		if rule.Experience != experience {
+			continue
+		}
+
+		if p.TraitProgress == nil {
+			p.TraitProgress = make(map[string]int)
+		}
+		p.TraitProgress[rule.Trait]++
+		if p.TraitProgress[rule.Trait] >= rule.Threshold {
+			change = p.gainTrait(rule)
+		}
+	}
+	return change
+}
+
+// gainTrait replaces the pet's trait in the rule's category with the rule's trait
+func (p *Pet) gainTrait(rule TraitDriftRule) *TraitChange {
+	def := GetTraitDefinition(rule.Trait)
+	if def == nil {
+		return nil
+	}
+
+	change := TraitChange{Time: TimeNow(), To: def.Name, Reason: rule.Reason}
+	replaced := false
+	for i, trait := range p.Traits {
+		if trait.Category == def.Category {
+			change.From = trait.Name
+			p.Traits[i] = def.Trait()
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		p.Traits = append(p.Traits, def.Trait())
+	}
+
+	delete(p.TraitProgress, rule.Trait)
+	p.TraitHistory = append(p.TraitHistory, change)
+	log.Printf("Trait changed: %s → %s (%s)", change.From, change.To, change.Reason)
+	return &change
+}
+
+// Describe returns a one-line summary of the trait change
+func (c TraitChange) Describe() string {
+	if c.From == "" {
+		return fmt.Sprintf("became %s (%s)", c.To, c.Reason)
+	}
+	return fmt.Sprintf("%s → %s (%s)", c.From, c.To, c.Reason)
+}
+
+// GetTraitDriftDisplay lists traits the pet is growing toward with their progress
+func GetTraitDriftDisplay(p Pet) string {
+	var parts []string
+	for _, rule := range GetTraitDriftRules() {
+		progress := p.TraitProgress[rule.Trait]
+		if progress == 0 || p.HasTrait(rule.Trait) {
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s %d%%", rule.Trait, progress*100/rule.Threshold))
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ", ")
+}
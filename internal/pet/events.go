@@ -1,6 +1,7 @@
 package pet
 
 import (
+	"fmt"
 	"log"
 	"time"
 )
@@ -30,6 +31,7 @@ type EventDefinition struct {
 	OnIgnored   func(p *Pet)
 	OnResponded func(p *Pet) string
 	Chance      float64
+	Passive     bool // Raised by the game rather than the pet, so letting it pass isn't ignoring the pet
 }
 
 // GetEventDefinitions returns all possible events with their properties
@@ -65,6 +67,7 @@ func GetEventDefinitions() []EventDefinition {
 				if RandFloat64() < 0.5 {
 					p.Health = max(p.Health-10, MinStat)
 				}
+				p.CatchDiseaseWithChance(DiseaseCold, FoundSomethingContagion)
 			},
 			OnResponded: func(p *Pet) string {
 				roll := RandFloat64()
@@ -76,6 +79,9 @@ func GetEventDefinitions() []EventDefinition {
 					return "🍪 It was a tasty treat! (+20 hunger)"
 				} else {
 					p.Health = max(p.Health-5, MinStat)
+					if p.CatchDiseaseWithChance(DiseaseCold, FoundSomethingContagion) {
+						return "🗑️ It was trash... and now they're sniffling. (-5 health)"
+					}
 					return "🗑️ It was trash... you threw it away. (-5 health)"
 				}
 			},
@@ -131,7 +137,7 @@ func GetEventDefinitions() []EventDefinition {
 			},
 			OnIgnored: func(p *Pet) {
 				p.Health = max(p.Health-20, MinStat)
-				p.Illness = true
+				p.CatchDisease(DiseaseUpsetStomach)
 			},
 			OnResponded: func(p *Pet) string {
 				p.Health = max(p.Health-5, MinStat)
@@ -213,6 +219,32 @@ func GetEventDefinitions() []EventDefinition {
 			},
 			Chance: 0.12,
 		},
+		{
+			Type:     EventLearnedTrick,
+			Emoji:    "🎓",
+			Message:  "showing off a trick!",
+			Duration: 10 * time.Minute,
+			Condition: func(p *Pet) bool {
+				return !p.Sleeping && len(p.Tricks) > 0 && p.Happiness > 50
+			},
+			OnResponded: func(p *Pet) string {
+				// Credit the trick the event taught; events raised at random show off the best one
+				trick := p.findTrick(p.CurrentEvent.Trick)
+				if trick == nil {
+					trick = p.BestTrick()
+				}
+				if trick == nil {
+					return ""
+				}
+				trick.Proficiency = min(trick.Proficiency+TrickPracticeGain, MaxStat)
+				trick.LastPracticed = TimeNow()
+				p.Happiness = min(p.Happiness+10, MaxStat)
+				return fmt.Sprintf("👏 You cheered their %s! %s %d%% (+10 happiness)",
+					trick.Name, GetTrickLevel(trick.Proficiency), trick.Proficiency)
+			},
+			Chance:  0.05,
+			Passive: true,
+		},
 	}
 }
 
@@ -227,7 +259,7 @@ func GetEventDefinition(eventType string) *EventDefinition {
 }
 
 // TriggerRandomEvent attempts to trigger a random event based on conditions
-func TriggerRandomEvent(p *Pet) {
+func TriggerRandomEvent(p *Pet, quiet QuietSchedule) {
 	now := TimeNow()
 
 	// Don't trigger if there's already an active event
@@ -235,6 +267,19 @@ func TriggerRandomEvent(p *Pet) {
 		return
 	}
 
+	// Events that run out during quiet hours pass without consequences
+	if p.CurrentEvent != nil && !p.CurrentEvent.Responded && quiet.IsQuiet(p.CurrentEvent.ExpiresAt) {
+		log.Printf("Event %s expired during quiet hours", p.CurrentEvent.Type)
+		p.CurrentEvent = nil
+	}
+
+	// Passive events simply run out
+	if p.CurrentEvent != nil && !p.CurrentEvent.Responded {
+		if def := GetEventDefinition(p.CurrentEvent.Type); def != nil && def.Passive {
+			p.CurrentEvent = nil
+		}
+	}
+
 	// If there was an expired event that wasn't responded to, apply consequences
 	if p.CurrentEvent != nil && !p.CurrentEvent.Responded {
 		def := GetEventDefinition(p.CurrentEvent.Type)
@@ -242,6 +287,7 @@ func TriggerRandomEvent(p *Pet) {
 			def.OnIgnored(p)
 			log.Printf("Event %s was ignored, applying consequences", p.CurrentEvent.Type)
 		}
+		p.AddTraitExperience("ignored_" + p.CurrentEvent.Type)
 		p.EventLog = append(p.EventLog, EventLogEntry{
 			Type:       p.CurrentEvent.Type,
 			Time:       p.CurrentEvent.StartTime,
@@ -250,15 +296,15 @@ func TriggerRandomEvent(p *Pet) {
 		p.CurrentEvent = nil
 	}
 
-	// Dead pets don't get events
-	if p.Dead {
+	// Dead pets don't get events, and nothing demands attention during quiet hours
+	if p.Dead || quiet.IsQuiet(now) {
 		return
 	}
 
 	// Try to trigger a new event
 	definitions := GetEventDefinitions()
 	for _, def := range definitions {
-		if def.Condition(p) && RandFloat64() < def.Chance {
+		if def.Condition(p) && RandFloat64() < def.Chance*p.GetEventChanceModifier(def.Type)*p.GetWeightEventModifier(def.Type) {
 			p.CurrentEvent = &Event{
 				Type:      def.Type,
 				StartTime: now,
@@ -288,6 +334,9 @@ func (p *Pet) RespondToEvent() string {
 	}
 
 	p.CurrentEvent.Responded = true
+	p.EventsResponded++
+	p.EarnCoins(GetTuning().Economy.EventReward, "Responded to "+def.Type)
+	p.AddTraitExperience("responded_" + p.CurrentEvent.Type)
 
 	p.EventLog = append(p.EventLog, EventLogEntry{
 		Type:       p.CurrentEvent.Type,
@@ -0,0 +1,561 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// FormDefinition describes a single evolution form
+type FormDefinition struct {
+	Form   PetForm
+	Name   string
+	Emoji  string
+	Stage  int
+	Secret bool // Hidden from previews until the pet reaches it
+}
+
+// GetFormDefinitions returns every form in the evolution tree
+func GetFormDefinitions() []FormDefinition {
+	return []FormDefinition{
+		{Form: FormBaby, Name: "Baby", Emoji: "🐣", Stage: StageBaby},
+
+		{Form: FormHealthyChild, Name: "Healthy Child", Emoji: "😊", Stage: StageChild},
+		{Form: FormTroubledChild, Name: "Troubled Child", Emoji: "😟", Stage: StageChild},
+		{Form: FormSicklyChild, Name: "Sickly Child", Emoji: "🤒", Stage: StageChild},
+
+		{Form: FormHealthyTeen, Name: "Healthy Teen", Emoji: "😃", Stage: StageTeen},
+		{Form: FormTroubledTeen, Name: "Troubled Teen", Emoji: "😒", Stage: StageTeen},
+		{Form: FormSicklyTeen, Name: "Sickly Teen", Emoji: "🤧", Stage: StageTeen},
+		{Form: FormProdigyTeen, Name: "Prodigy Teen", Emoji: "🤓", Stage: StageTeen},
+		{Form: FormRebelTeen, Name: "Rebel Teen", Emoji: "😎", Stage: StageTeen},
+
+		{Form: FormEliteAdult, Name: "Elite Adult", Emoji: "⭐", Stage: StageAdult},
+		{Form: FormStandardAdult, Name: "Standard Adult", Emoji: "😺", Stage: StageAdult},
+		{Form: FormGrumpyAdult, Name: "Grumpy Adult", Emoji: "😼", Stage: StageAdult},
+		{Form: FormRedeemedAdult, Name: "Redeemed Adult", Emoji: "😸", Stage: StageAdult},
+		{Form: FormDelinquentAdult, Name: "Delinquent Adult", Emoji: "😾", Stage: StageAdult},
+		{Form: FormWeakAdult, Name: "Weak Adult", Emoji: "🤕", Stage: StageAdult},
+		{Form: FormDevotedAdult, Name: "Devoted Adult", Emoji: "🥰", Stage: StageAdult},
+		{Form: FormPerformerAdult, Name: "Performer Adult", Emoji: "🎭", Stage: StageAdult},
+		{Form: FormFreeSpiritAdult, Name: "Free Spirit Adult", Emoji: "🌈", Stage: StageAdult},
+		{Form: FormSurvivorAdult, Name: "Survivor Adult", Emoji: "💪", Stage: StageAdult},
+		{Form: FormPhoenixAdult, Name: "Phoenix Adult", Emoji: "🔥", Stage: StageAdult, Secret: true},
+
+		{Form: FormWiseElder, Name: "Wise Elder", Emoji: "🧙", Stage: StageSenior},
+		{Form: FormSereneElder, Name: "Serene Elder", Emoji: "😌", Stage: StageSenior},
+		{Form: FormGoldenElder, Name: "Golden Elder", Emoji: "🏅", Stage: StageSenior},
+		{Form: FormCrankyElder, Name: "Cranky Elder", Emoji: "😤", Stage: StageSenior},
+		{Form: FormFrailElder, Name: "Frail Elder", Emoji: "👴", Stage: StageSenior},
+		{Form: FormLegendElder, Name: "Legend Elder", Emoji: "🐉", Stage: StageSenior, Secret: true},
+	}
+}
+
+// GetFormDefinition returns the definition for a form, or nil if unknown
+func GetFormDefinition(form PetForm) *FormDefinition {
+	for _, def := range GetFormDefinitions() {
+		if def.Form == form {
+			return &def
+		}
+	}
+	return nil
+}
+
+// GetFormName returns the display name for the pet's current form
+func (p *Pet) GetFormName() string {
+	if def := GetFormDefinition(p.Form); def != nil {
+		return def.Name
+	}
+	return "Unknown"
+}
+
+// GetFormEmoji returns the emoji for the pet's current form in the active rendering profile
+func (p *Pet) GetFormEmoji() string {
+	if def := GetFormDefinition(p.Form); def != nil {
+		return RenderText(def.Emoji)
+	}
+	return RenderText("❓")
+}
+
+// GetLifeStageOrder returns life stages in chronological order
+func GetLifeStageOrder() []int {
+	return []int{StageBaby, StageChild, StageTeen, StageAdult, StageSenior}
+}
+
+// GetLifeStageForAge returns the life stage for an age in hours
+func GetLifeStageForAge(age int) int {
+	switch {
+	case age >= SeniorStageAge:
+		return StageSenior
+	case age >= AdultStageAge:
+		return StageAdult
+	case age >= TeenStageAge:
+		return StageTeen
+	case age >= ChildStageAge:
+		return StageChild
+	default:
+		return StageBaby
+	}
+}
+
+// GetLifeStageName returns a display name for a life stage
+func GetLifeStageName(stage int) string {
+	switch stage {
+	case StageBaby:
+		return "Baby"
+	case StageChild:
+		return "Child"
+	case StageTeen:
+		return "Teen"
+	case StageAdult:
+		return "Adult"
+	case StageSenior:
+		return "Senior"
+	default:
+		return "Unknown"
+	}
+}
+
+// lifeStageIndex returns a stage's chronological position, or -1 if unknown
+func lifeStageIndex(stage int) int {
+	for i, s := range GetLifeStageOrder() {
+		if s == stage {
+			return i
+		}
+	}
+	return -1
+}
+
+// PreviousLifeStage returns the stage before the given one (baby has none and returns itself)
+func PreviousLifeStage(stage int) int {
+	order := GetLifeStageOrder()
+	index := lifeStageIndex(stage)
+	if index <= 0 {
+		return StageBaby
+	}
+	return order[index-1]
+}
+
+// lifeStagesBetween returns the stages after from, up to and including to
+func lifeStagesBetween(from, to int) []int {
+	order := GetLifeStageOrder()
+	fromIndex, toIndex := lifeStageIndex(from), lifeStageIndex(to)
+	if fromIndex < 0 || toIndex <= fromIndex {
+		return nil
+	}
+	return order[fromIndex+1 : toIndex+1]
+}
+
+// EvolutionContext summarizes everything an evolution branch may consider
+type EvolutionContext struct {
+	Care            CareQuality
+	Bond            int
+	Traits          map[string]bool
+	EventCount      int
+	ResponseRate    int // % of logged events the owner responded to
+	CompetentTricks int
+	MasteredTricks  int
+}
+
+// HasResponseHistory reports whether enough events were logged to judge the owner
+func (ctx EvolutionContext) HasResponseHistory() bool {
+	return ctx.EventCount >= MinEventsForResponseRate
+}
+
+// EvolutionBranch is one edge of the evolution tree
+type EvolutionBranch struct {
+	From        PetForm
+	To          PetForm
+	Hint        string                      // Requirement description for previews
+	Requirement func(EvolutionContext) bool // nil always matches
+}
+
+// GetEvolutionTree returns all branches. For each form the first matching branch wins,
+// so special branches are listed before the care-based fallbacks.
+func GetEvolutionTree() []EvolutionBranch {
+	careAtLeast := func(threshold int) func(EvolutionContext) bool {
+		return func(ctx EvolutionContext) bool { return ctx.Care.OverallAverage() >= threshold }
+	}
+
+	tree := []EvolutionBranch{
+		// Baby → Child
+		{From: FormBaby, To: FormHealthyChild, Hint: "Care 70%+", Requirement: careAtLeast(GoodCareThreshold)},
+		{From: FormBaby, To: FormTroubledChild, Hint: "Care 40%+", Requirement: careAtLeast(PoorCareThreshold)},
+		{From: FormBaby, To: FormSicklyChild, Hint: "Care below 40%"},
+
+		// Child → Teen
+		{From: FormHealthyChild, To: FormProdigyTeen, Hint: "Care 70%+ and 2 competent tricks",
+			Requirement: func(ctx EvolutionContext) bool {
+				return ctx.Care.OverallAverage() >= GoodCareThreshold && ctx.CompetentTricks >= 2
+			}},
+		{From: FormHealthyChild, To: FormRebelTeen, Hint: "Most events ignored",
+			Requirement: func(ctx EvolutionContext) bool {
+				return ctx.HasResponseHistory() && ctx.ResponseRate < LowResponseRate
+			}},
+		{From: FormHealthyChild, To: FormHealthyTeen},
+		{From: FormTroubledChild, To: FormRebelTeen, Hint: "Most events ignored",
+			Requirement: func(ctx EvolutionContext) bool {
+				return ctx.HasResponseHistory() && ctx.ResponseRate < LowResponseRate
+			}},
+		{From: FormTroubledChild, To: FormTroubledTeen},
+		{From: FormSicklyChild, To: FormSicklyTeen},
+
+		// Teen → Adult
+		{From: FormHealthyTeen, To: FormDevotedAdult, Hint: "Care 70%+ and bond 90+",
+			Requirement: func(ctx EvolutionContext) bool {
+				return ctx.Care.OverallAverage() >= GoodCareThreshold && ctx.Bond >= DevotedBondThreshold
+			}},
+		{From: FormHealthyTeen, To: FormEliteAdult, Hint: "Care 85%+", Requirement: careAtLeast(PerfectCareThreshold)},
+		{From: FormHealthyTeen, To: FormStandardAdult, Hint: "Care 70%+", Requirement: careAtLeast(GoodCareThreshold)},
+		{From: FormHealthyTeen, To: FormGrumpyAdult, Hint: "Care below 70%"},
+		{From: FormProdigyTeen, To: FormPerformerAdult, Hint: "3 mastered tricks",
+			Requirement: func(ctx EvolutionContext) bool { return ctx.MasteredTricks >= 3 }},
+		{From: FormProdigyTeen, To: FormEliteAdult, Hint: "Care 85%+", Requirement: careAtLeast(PerfectCareThreshold)},
+		{From: FormProdigyTeen, To: FormStandardAdult, Hint: "Care 70%+", Requirement: careAtLeast(GoodCareThreshold)},
+		{From: FormProdigyTeen, To: FormGrumpyAdult, Hint: "Care below 70%"},
+		{From: FormRebelTeen, To: FormFreeSpiritAdult, Hint: "Independent and care 70%+",
+			Requirement: func(ctx EvolutionContext) bool {
+				return ctx.Traits["Independent"] && ctx.Care.OverallAverage() >= GoodCareThreshold
+			}},
+		{From: FormRebelTeen, To: FormRedeemedAdult, Hint: "Care 70%+", Requirement: careAtLeast(GoodCareThreshold)},
+		{From: FormRebelTeen, To: FormDelinquentAdult, Hint: "Care below 70%"},
+		{From: FormTroubledTeen, To: FormRedeemedAdult, Hint: "Care 70%+", Requirement: careAtLeast(GoodCareThreshold)},
+		{From: FormTroubledTeen, To: FormDelinquentAdult, Hint: "Care below 70%"},
+		{From: FormSicklyTeen, To: FormPhoenixAdult, Hint: "???",
+			Requirement: func(ctx EvolutionContext) bool {
+				return ctx.Care.OverallAverage() >= PerfectCareThreshold && ctx.Bond >= PhoenixBondThreshold &&
+					ctx.HasResponseHistory() && ctx.ResponseRate >= HighResponseRate
+			}},
+		{From: FormSicklyTeen, To: FormSurvivorAdult, Hint: "Care 70%+ and most events answered",
+			Requirement: func(ctx EvolutionContext) bool {
+				return ctx.Care.OverallAverage() >= GoodCareThreshold &&
+					ctx.HasResponseHistory() && ctx.ResponseRate >= HighResponseRate
+			}},
+		{From: FormSicklyTeen, To: FormWeakAdult},
+	}
+
+	// Adult → Senior
+	for _, def := range GetFormDefinitions() {
+		if def.Stage == StageAdult {
+			tree = append(tree, seniorBranches(def.Form)...)
+		}
+	}
+	return tree
+}
+
+// seniorBranches returns the branches every adult form shares when becoming a senior
+func seniorBranches(from PetForm) []EvolutionBranch {
+	branches := []EvolutionBranch{
+		{From: from, To: FormLegendElder, Hint: "???",
+			Requirement: func(ctx EvolutionContext) bool {
+				return ctx.Bond >= LegendBondThreshold && ctx.MasteredTricks == len(GetTrickDefinitions())
+			}},
+		{From: from, To: FormWiseElder, Hint: "Care 70%+ and 3 mastered tricks",
+			Requirement: func(ctx EvolutionContext) bool {
+				return ctx.Care.OverallAverage() >= GoodCareThreshold && ctx.MasteredTricks >= 3
+			}},
+		{From: from, To: FormSereneElder, Hint: "Calm and care 70%+",
+			Requirement: func(ctx EvolutionContext) bool {
+				return ctx.Traits["Calm"] && ctx.Care.OverallAverage() >= GoodCareThreshold
+			}},
+	}
+
+	switch from {
+	case FormWeakAdult:
+		branches = append(branches, EvolutionBranch{From: from, To: FormGoldenElder, Hint: "Care 85%+",
+			Requirement: func(ctx EvolutionContext) bool { return ctx.Care.OverallAverage() >= PerfectCareThreshold }})
+		branches = append(branches, EvolutionBranch{From: from, To: FormFrailElder, Hint: "Care below 85%"})
+	case FormGrumpyAdult, FormDelinquentAdult:
+		branches = append(branches, EvolutionBranch{From: from, To: FormGoldenElder, Hint: "Care 85%+",
+			Requirement: func(ctx EvolutionContext) bool { return ctx.Care.OverallAverage() >= PerfectCareThreshold }})
+		branches = append(branches, EvolutionBranch{From: from, To: FormCrankyElder, Hint: "Care below 85%"})
+	default:
+		branches = append(branches, EvolutionBranch{From: from, To: FormFrailElder, Hint: "Care below 40%",
+			Requirement: func(ctx EvolutionContext) bool { return ctx.Care.OverallAverage() < PoorCareThreshold }})
+		branches = append(branches, EvolutionBranch{From: from, To: FormCrankyElder, Hint: "Care below 70%",
+			Requirement: func(ctx EvolutionContext) bool { return ctx.Care.OverallAverage() < GoodCareThreshold }})
+		branches = append(branches, EvolutionBranch{From: from, To: FormGoldenElder, Hint: "Care 70%+"})
+	}
+	return branches
+}
+
+// GetEvolutionBranches returns the branches available from a form, in priority order
+func GetEvolutionBranches(from PetForm) []EvolutionBranch {
+	var branches []EvolutionBranch
+	for _, branch := range GetEvolutionTree() {
+		if branch.From == from {
+			branches = append(branches, branch)
+		}
+	}
+	return branches
+}
+
+// NewEvolutionContext gathers the pet's current evolution criteria using the given care quality
+func (p *Pet) NewEvolutionContext(care CareQuality) EvolutionContext {
+	ctx := EvolutionContext{
+		Care:   care,
+		Bond:   p.Bond,
+		Traits: make(map[string]bool),
+	}
+	for _, trait := range p.Traits {
+		ctx.Traits[trait.Name] = true
+	}
+
+	responded := 0
+	for _, entry := range p.EventLog {
+		if !entry.WasIgnored {
+			responded++
+		}
+	}
+	ctx.EventCount = len(p.EventLog)
+	if ctx.EventCount > 0 {
+		ctx.ResponseRate = responded * 100 / ctx.EventCount
+	}
+
+	for _, trick := range p.Tricks {
+		if trick.Proficiency >= TrickCompetentThreshold {
+			ctx.CompetentTricks++
+		}
+		if trick.Proficiency >= TrickMasteredThreshold {
+			ctx.MasteredTricks++
+		}
+	}
+	return ctx
+}
+
+// StageCareQuality returns care quality for a stage. Stages with no checkpoints
+// (e.g. skipped while vpet wasn't running) inherit the most recent recorded stage.
+func (p *Pet) StageCareQuality(stage int) CareQuality {
+	for {
+		if len(p.StatCheckpoints[fmt.Sprintf("stage_%d", stage)]) > 0 || stage == StageBaby {
+			return p.CalculateCareQuality(stage)
+		}
+		stage = PreviousLifeStage(stage)
+	}
+}
+
+// SelectEvolution returns the form the pet would become given an evolution context
+func SelectEvolution(from PetForm, ctx EvolutionContext) (PetForm, bool) {
+	for _, branch := range GetEvolutionBranches(from) {
+		if branch.Requirement == nil || branch.Requirement(ctx) {
+			return branch.To, true
+		}
+	}
+	return from, false
+}
+
+// Evolve handles pet evolution when life stage changes
+func (p *Pet) Evolve(newStage int) {
+	prevStage := PreviousLifeStage(newStage)
+	careQuality := p.StageCareQuality(prevStage)
+
+	if p.CareQualityHistory == nil {
+		p.CareQualityHistory = make(map[int]CareQuality)
+	}
+	p.CareQualityHistory[prevStage] = careQuality
+
+	newForm, ok := SelectEvolution(p.Form, p.NewEvolutionContext(careQuality))
+	if def := GetFormDefinition(newForm); !ok || def == nil || def.Stage != newStage {
+		log.Printf("No evolution branch from %s to stage %s", p.GetFormName(), GetLifeStageName(newStage))
+		return
+	}
+	p.EvolutionHistory = append(p.EvolutionHistory, EvolutionRecord{
+		From:        p.Form,
+		To:          newForm,
+		Stage:       newStage,
+		Time:        TimeNow(),
+		CareQuality: careQuality.OverallAverage(),
+	})
+	p.Form = newForm
+
+	log.Printf("Pet evolved to %s (care quality: %d%%)", p.GetFormName(), careQuality.OverallAverage())
+}
+
+// EvolutionRecord is one entry in the pet's lineage
+type EvolutionRecord struct {
+	From        PetForm   `json:"from"`
+	To          PetForm   `json:"to"`
+	Stage       int       `json:"stage"`
+	Time        time.Time `json:"time"`
+	CareQuality int       `json:"care_quality"`
+	Announced   bool      `json:"announced,omitempty"` // Ceremony has been shown in the TUI
+}
+
+// UpdateLifeStage recalculates age and life stage, evolving through any stages reached.
+// Returns the evolutions that happened.
+func (p *Pet) UpdateLifeStage(now time.Time) []EvolutionRecord {
+	if len(p.Logs) == 0 {
+		return nil
+	}
+	p.Age = p.AgeAt(now)
+
+	oldLifeStage := p.LifeStage
+	p.LifeStage = GetLifeStageForAge(p.Age)
+
+	before := len(p.EvolutionHistory)
+	for _, stage := range lifeStagesBetween(oldLifeStage, p.LifeStage) {
+		p.Evolve(stage)
+	}
+	return p.EvolutionHistory[before:]
+}
+
+// PendingEvolutions returns evolutions whose ceremony hasn't been shown yet
+func (p *Pet) PendingEvolutions() []EvolutionRecord {
+	var pending []EvolutionRecord
+	for _, record := range p.EvolutionHistory {
+		if !record.Announced {
+			pending = append(pending, record)
+		}
+	}
+	return pending
+}
+
+// MarkEvolutionsAnnounced flags every evolution as shown
+func (p *Pet) MarkEvolutionsAnnounced() {
+	for i := range p.EvolutionHistory {
+		p.EvolutionHistory[i].Announced = true
+	}
+}
+
+// GetLineageDisplay returns the pet's evolution path as a chain of form emojis
+func GetLineageDisplay(p Pet) string {
+	if len(p.EvolutionHistory) == 0 {
+		return p.GetFormEmoji() + " " + p.GetFormName()
+	}
+	first := Pet{Form: p.EvolutionHistory[0].From}
+	chain := []string{first.GetFormEmoji()}
+	for _, record := range p.EvolutionHistory {
+		form := Pet{Form: record.To}
+		chain = append(chain, form.GetFormEmoji())
+	}
+	return strings.Join(chain, " → ")
+}
+
+// GetLifeStageStartAge returns the age in hours at which a stage begins
+func GetLifeStageStartAge(stage int) int {
+	switch stage {
+	case StageChild:
+		return ChildStageAge
+	case StageTeen:
+		return TeenStageAge
+	case StageAdult:
+		return AdultStageAge
+	case StageSenior:
+		return SeniorStageAge
+	default:
+		return 0
+	}
+}
+
+// NextLifeStage returns the stage after the given one and whether one exists
+func NextLifeStage(stage int) (int, bool) {
+	order := GetLifeStageOrder()
+	index := lifeStageIndex(stage)
+	if index < 0 || index >= len(order)-1 {
+		return stage, false
+	}
+	return order[index+1], true
+}
+
+// StatAverage is a single stat's running care average
+type StatAverage struct {
+	Name  string
+	Value int
+}
+
+// EvolutionForecast previews where the pet is heading at its next stage change
+type EvolutionForecast struct {
+	Stage         int
+	NextStage     int
+	HasNextStage  bool
+	Care          CareQuality
+	Checkpoints   int // Checkpoints recorded in the current stage
+	ProjectedForm PetForm
+	Secret        bool // Projected form is secret and shouldn't be revealed
+	TimeUntil     time.Duration
+	WeakestStat   StatAverage
+}
+
+// ForecastEvolution projects the pet's next evolution from the current stage's running care
+func ForecastEvolution(p Pet, now time.Time) EvolutionForecast {
+	care := p.StageCareQuality(p.LifeStage)
+	forecast := EvolutionForecast{
+		Stage:         p.LifeStage,
+		Care:          care,
+		Checkpoints:   len(p.StatCheckpoints[fmt.Sprintf("stage_%d", p.LifeStage)]),
+		ProjectedForm: p.Form,
+	}
+
+	averages := forecast.StatAverages()
+	forecast.WeakestStat = averages[0]
+	for _, avg := range averages[1:] {
+		if avg.Value < forecast.WeakestStat.Value {
+			forecast.WeakestStat = avg
+		}
+	}
+
+	nextStage, ok := NextLifeStage(p.LifeStage)
+	if !ok {
+		return forecast
+	}
+	forecast.NextStage = nextStage
+	forecast.HasNextStage = true
+
+	if form, ok := SelectEvolution(p.Form, p.NewEvolutionContext(care)); ok {
+		forecast.ProjectedForm = form
+		if def := GetFormDefinition(form); def != nil {
+			forecast.Secret = def.Secret
+		}
+	}
+
+	if len(p.Logs) > 0 {
+		stageStart := float64(GetLifeStageStartAge(nextStage)) + p.PausedHours(now)
+		evolvesAt := p.Logs[0].Time.Add(time.Duration(stageStart * float64(time.Hour)))
+		forecast.TimeUntil = evolvesAt.Sub(now)
+		if forecast.TimeUntil < 0 {
+			forecast.TimeUntil = 0
+		}
+	}
+	return forecast
+}
+
+// StatAverages returns the per-stat care averages in display order
+func (f EvolutionForecast) StatAverages() []StatAverage {
+	return []StatAverage{
+		{Name: "Hunger", Value: f.Care.AvgHunger},
+		{Name: "Happiness", Value: f.Care.AvgHappiness},
+		{Name: "Energy", Value: f.Care.AvgEnergy},
+		{Name: "Health", Value: f.Care.AvgHealth},
+		{Name: "Cleanliness", Value: f.Care.AvgCleanliness},
+	}
+}
+
+// ProjectedFormDisplay returns the projected form's emoji and name, hiding secret forms
+func (f EvolutionForecast) ProjectedFormDisplay() string {
+	if !f.HasNextStage {
+		return "Final form"
+	}
+	if f.Secret {
+		return "❓ ???"
+	}
+	if def := GetFormDefinition(f.ProjectedForm); def != nil {
+		return def.Emoji + " " + def.Name
+	}
+	return "❓ Unknown"
+}
+
+// TimeUntilDisplay formats the time remaining until the next evolution
+func (f EvolutionForecast) TimeUntilDisplay() string {
+	if !f.HasNextStage {
+		return "-"
+	}
+	hours := int(f.TimeUntil.Hours())
+	if hours >= 24 {
+		return fmt.Sprintf("%dd %dh", hours/24, hours%24)
+	}
+	if hours > 0 {
+		return fmt.Sprintf("%dh %dm", hours, int(f.TimeUntil.Minutes())%60)
+	}
+	return fmt.Sprintf("%dm", int(f.TimeUntil.Minutes()))
+}
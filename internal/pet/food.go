@@ -0,0 +1,212 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Food type constants
+const (
+	FoodKibble     = "kibble"
+	FoodTreat      = "treat"
+	FoodFish       = "fish"
+	FoodVegetables = "vegetables"
+)
+
+// FoodDefinition describes a food's nutrition and how much of it the pantry holds
+type FoodDefinition struct {
+	Type        string
+	Name        string
+	Emoji       string
+	Message     string
+	Hunger      int
+	Happiness   int
+	Health      int  // Negative for unhealthy food
+	Junk        bool // Too much junk food in a short time hurts health
+	Mess        int  // Cleanliness lost from eating it
+	Weight      int  // Weight gained from eating it
+	DailyRation int  // Amount restocked each day
+	MaxStock    int
+}
+
+// GetFoodDefinitions returns every food in menu order
+func GetFoodDefinitions() []FoodDefinition {
+	return []FoodDefinition{
+		{
+			Type:        FoodKibble,
+			Name:        "Kibble",
+			Emoji:       "🍖",
+			Message:     "Yum!",
+			Hunger:      FeedHungerIncrease,
+			Happiness:   FeedHappinessIncrease,
+			Mess:        5,
+			Weight:      2,
+			DailyRation: 6,
+			MaxStock:    12,
+		},
+		{
+			Type:        FoodTreat,
+			Name:        "Treat",
+			Emoji:       "🍪",
+			Message:     "A tasty treat!",
+			Hunger:      10,
+			Happiness:   25,
+			Health:      -2,
+			Junk:        true,
+			Mess:        3,
+			Weight:      4,
+			DailyRation: 2,
+			MaxStock:    5,
+		},
+		{
+			Type:        FoodFish,
+			Name:        "Fish",
+			Emoji:       "🐟",
+			Message:     "Fresh fish!",
+			Hunger:      35,
+			Happiness:   15,
+			Health:      5,
+			Mess:        10,
+			Weight:      1,
+			DailyRation: 1,
+			MaxStock:    3,
+		},
+		{
+			Type:        FoodVegetables,
+			Name:        "Vegetables",
+			Emoji:       "🥦",
+			Message:     "Crunchy and healthy!",
+			Hunger:      20,
+			Health:      10,
+			Mess:        5,
+			DailyRation: 3,
+			MaxStock:    6,
+		},
+	}
+}
+
+// GetFoodDefinition returns the definition for a food type
+func GetFoodDefinition(foodType string) *FoodDefinition {
+	for _, def := range GetFoodDefinitions() {
+		if def.Type == foodType {
+			return &def
+		}
+	}
+	return nil
+}
+
+// RestockPantry adds each food's daily ration for every full day since the last restock.
+// Pets from before the pantry existed start with one day's rations.
+func (p *Pet) RestockPantry(now time.Time) {
+	if p.Inventory == nil {
+		p.Inventory = make(map[string]int)
+		for _, food := range GetFoodDefinitions() {
+			p.Inventory[food.Type] = food.DailyRation
+		}
+		p.LastRestock = now
+		return
+	}
+
+	days := int(now.Sub(p.LastRestock).Hours() / 24)
+	if days <= 0 {
+		return
+	}
+	for _, food := range GetFoodDefinitions() {
+		p.Inventory[food.Type] = min(p.Inventory[food.Type]+food.DailyRation*days, food.MaxStock)
+	}
+	p.LastRestock = p.LastRestock.Add(time.Duration(days) * 24 * time.Hour)
+	log.Printf("Restocked pantry after %d day(s)", days)
+}
+
+// countRecentJunkFood counts junk food eaten within the junk food window
+func (p *Pet) countRecentJunkFood() int {
+	now := TimeNow()
+	count := 0
+	for _, interaction := range p.LastInteractions {
+		if interaction.Type != "feed" || now.Sub(interaction.Time) >= JunkFoodWindow {
+			continue
+		}
+		if food := GetFoodDefinition(interaction.Food); food != nil && food.Junk {
+			count++
+		}
+	}
+	return count
+}
+
+// Feed gives the pet a food from the pantry. Returns a message and whether the pet ate.
+func (p *Pet) Feed(foodType string) (string, bool) {
+	food := GetFoodDefinition(foodType)
+	if food == nil {
+		return fmt.Sprintf("❓ Unknown food %q", foodType), false
+	}
+	if p.Dead {
+		return "", false
+	}
+	if p.Hunger >= 90 {
+		return "🍽️ Not hungry right now!", false
+	}
+	if p.Inventory[food.Type] <= 0 {
+		return fmt.Sprintf("%s Out of %s!", food.Emoji, strings.ToLower(food.Name)), false
+	}
+	if msg, refused := p.CheckTraitRefusal("feed"); refused {
+		return msg, false
+	}
+	if msg, refused := p.CheckTraitRefusal("eat_" + food.Type); refused {
+		return msg, false
+	}
+
+	recentFeeds := CountRecentInteractions(p.LastInteractions, "feed", SpamPreventionWindow)
+	recentJunk := p.countRecentJunkFood()
+	hungerBefore := p.Hunger
+
+	p.Sleeping = false
+	p.AutoSleepTime = nil
+	p.FractionalEnergy = 0
+
+	effectiveness := 1.0
+	if recentFeeds > 0 {
+		effectiveness = 1.0 / float64(recentFeeds+1)
+	}
+
+	bondMultiplier := p.GetBondMultiplier()
+	hungerGain := int(float64(food.Hunger) * p.GetTraitModifier("feed_bonus") * effectiveness * bondMultiplier)
+	happinessGain := int(float64(food.Happiness) * p.GetTraitModifier("feed_bonus_happiness") * effectiveness * bondMultiplier)
+
+	p.Hunger = min(p.Hunger+hungerGain, MaxStat)
+	p.Happiness = min(p.Happiness+happinessGain, MaxStat)
+	p.Health = max(min(p.Health+food.Health, MaxStat), MinStat)
+	p.Cleanliness = max(p.Cleanliness-food.Mess, MinStat)
+	p.ChangeWeight(food.Weight + recentFeeds*FrequentFeedWeightGain)
+	p.Inventory[food.Type]--
+	p.AddInteraction("feed")
+	p.LastInteractions[len(p.LastInteractions)-1].Food = food.Type
+
+	messages := []string{food.Emoji + " " + food.Message}
+	if hungerBefore >= OverfeedThreshold {
+		p.Health = max(p.Health-OverfeedHealthPenalty, MinStat)
+		p.ChangeWeight(OverfeedWeightGain)
+		messages = append(messages, fmt.Sprintf("😣 Ate too much! (-%d health)", OverfeedHealthPenalty))
+	}
+	if food.Junk && recentJunk >= JunkFoodLimit {
+		p.Health = max(p.Health-JunkFoodHealthPenalty, MinStat)
+		messages = append(messages, fmt.Sprintf("🤢 Too much junk food! (-%d health)", JunkFoodHealthPenalty))
+	}
+
+	if recentFeeds == 0 && hungerBefore < 50 {
+		p.UpdateBond(BondGainWellTimed)
+		p.AddTraitExperience(ExperienceWellTimedCare)
+	} else if recentFeeds == 0 {
+		p.UpdateBond(BondGainNormal)
+	}
+
+	log.Printf("Fed pet %s (effectiveness: %.2f, bond mult: %.2f). Hunger is now %d, Happiness is now %d, Health is now %d, Weight is now %+d",
+		food.Type, effectiveness, bondMultiplier, p.Hunger, p.Happiness, p.Health, p.Weight)
+	return strings.Join(messages, " "), true
+}
+
+// GetFoodMenuLabel returns a food's menu entry with its remaining stock
+func GetFoodMenuLabel(p Pet, food FoodDefinition) string {
+	return fmt.Sprintf("%s %s x%d", food.Emoji, food.Name, p.Inventory[food.Type])
+}
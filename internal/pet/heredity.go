@@ -0,0 +1,128 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Ancestor records a predecessor in a pet's family tree
+type Ancestor struct {
+	Name         string    `json:"name"`
+	Form         PetForm   `json:"form"`
+	Born         time.Time `json:"born"`
+	Died         time.Time `json:"died"`
+	CauseOfDeath string    `json:"cause_of_death,omitempty"`
+	CareQuality  int       `json:"care_quality"`
+}
+
+// LifetimeCareQuality returns the average of every stat checkpoint across the pet's life,
+// or 0 if none were recorded
+func (p *Pet) LifetimeCareQuality() int {
+	total, count := 0, 0
+	for _, checkpoints := range p.StatCheckpoints {
+		for _, c := range checkpoints {
+			total += c.Average()
+			count++
+		}
+	}
+	if count == 0 {
+		return 0
+	}
+	return total / count
+}
+
+// GetInheritedBondBonus returns the starting bond bonus an heir gets for its parent's care
+func GetInheritedBondBonus(careQuality int) int {
+	if careQuality <= PoorCareThreshold {
+		return 0
+	}
+	return (careQuality - PoorCareThreshold) * MaxInheritedBondBonus / (MaxStat - PoorCareThreshold)
+}
+
+// NewHeir creates a new pet that inherits tendencies from its predecessor
+func NewHeir(parent Pet) Pet {
+	p := NewPet(nil)
+	if parent.TimeZone != "" {
+		p.TimeZone = parent.TimeZone
+	}
+
+	// Each of the parent's traits may replace the freshly rolled trait in its category
+	for _, inherited := range parent.Traits {
+		if RandFloat64() >= InheritTraitChance {
+			continue
+		}
+		for i := range p.Traits {
+			if p.Traits[i].Category == inherited.Category {
+				p.Traits[i] = inherited
+				log.Printf("Inherited %s trait: %s", inherited.Category, inherited.Name)
+			}
+		}
+	}
+
+	if parent.Chronotype != "" && RandFloat64() < InheritChronotypeChance {
+		p.Chronotype = parent.Chronotype
+		if parent.Schedule != nil {
+			schedule := *parent.Schedule
+			p.Schedule = &schedule
+		}
+		log.Printf("Inherited chronotype: %s", GetChronotypeName(p.Chronotype))
+	}
+
+	care := parent.LifetimeCareQuality()
+	bonus := GetInheritedBondBonus(care)
+	p.Bond = min(p.Bond+bonus, MaxBond)
+
+	ancestor := Ancestor{
+		Name:         parent.Name,
+		Form:         parent.Form,
+		Died:         parent.LastSaved,
+		CauseOfDeath: parent.CauseOfDeath,
+		CareQuality:  care,
+	}
+	if len(parent.Logs) > 0 {
+		ancestor.Born = parent.Logs[0].Time
+	}
+	if parent.DiedAt != nil {
+		ancestor.Died = *parent.DiedAt
+	}
+	p.Ancestry = append(append([]Ancestor{}, parent.Ancestry...), ancestor)
+
+	p.LastStatus = statusEmoji(p)
+	log.Printf("%s is generation %d, heir of %s (bond bonus %d)", p.Name, p.Generation(), parent.Name, bonus)
+	return p
+}
+
+// Generation returns how many pets the family line has had, counting this one
+func (p *Pet) Generation() int {
+	return len(p.Ancestry) + 1
+}
+
+// Parent returns the pet's direct predecessor, or nil for a first-generation pet
+func (p *Pet) Parent() *Ancestor {
+	if len(p.Ancestry) == 0 {
+		return nil
+	}
+	return &p.Ancestry[len(p.Ancestry)-1]
+}
+
+// GetFamilyDisplay returns a short description of the pet's place in its family line
+func GetFamilyDisplay(p Pet) string {
+	parent := p.Parent()
+	if parent == nil {
+		return "Gen 1"
+	}
+	return fmt.Sprintf("Gen %d, heir of %s", p.Generation(), parent.Name)
+}
+
+// GetFamilyTreeDisplay returns the family line from the founder down to this pet
+func GetFamilyTreeDisplay(p Pet) string {
+	var chain []string
+	for _, ancestor := range p.Ancestry {
+		form := Pet{Form: ancestor.Form}
+		chain = append(chain, form.GetFormEmoji()+" "+ancestor.Name)
+	}
+	chain = append(chain, p.GetFormEmoji()+" "+p.Name)
+	return strings.Join(chain, " → ")
+}
@@ -0,0 +1,75 @@
+package pet
+
+import (
+	"log"
+	"strings"
+)
+
+// legacyCleanliness marks a save from before the cleanliness stat existed
+const legacyCleanliness = -1
+
+// migrateHygiene gives saves from before the cleanliness stat a spotless history,
+// so the new stat doesn't drag down care quality that was already earned
+func (p *Pet) migrateHygiene() {
+	p.Cleanliness = MaxStat
+	for stage, checkpoints := range p.StatCheckpoints {
+		for i := range checkpoints {
+			checkpoints[i].Cleanliness = MaxStat
+		}
+		p.StatCheckpoints[stage] = checkpoints
+	}
+	for stage, care := range p.CareQualityHistory {
+		care.AvgCleanliness = MaxStat
+		p.CareQualityHistory[stage] = care
+	}
+	log.Printf("Migrated save to track cleanliness")
+}
+
+// IsDirty reports whether the pet's environment is dirty enough to hurt its health
+func (p *Pet) IsDirty() bool {
+	return p.Cleanliness < DirtyThreshold
+}
+
+// Clean tidies up after the pet. Returns a message and whether anything was cleaned.
+func (p *Pet) Clean() (string, bool) {
+	if p.Dead {
+		return "", false
+	}
+	if p.Cleanliness >= CleanRefuseThreshold {
+		return "✨ Already spotless!", false
+	}
+	if msg, refused := p.CheckTraitRefusal("clean"); refused {
+		return msg, false
+	}
+
+	recentCleans := CountRecentInteractions(p.LastInteractions, "clean", SpamPreventionWindow)
+	cleanlinessBefore := p.Cleanliness
+
+	bondMultiplier := p.GetBondMultiplier()
+	cleanGain := int(float64(CleanEffect) * bondMultiplier)
+	p.Cleanliness = min(p.Cleanliness+cleanGain, MaxStat)
+	p.AddInteraction("clean")
+
+	if recentCleans == 0 && cleanlinessBefore < 50 {
+		p.UpdateBond(BondGainWellTimed)
+		p.AddTraitExperience(ExperienceWellTimedCare)
+	} else if recentCleans == 0 {
+		p.UpdateBond(BondGainNormal)
+	}
+
+	log.Printf("Cleaned up after pet (bond mult: %.2f). Cleanliness is now %d", bondMultiplier, p.Cleanliness)
+
+	if cleanlinessBefore < DirtyThreshold {
+		return "🧹 What a mess... all clean now!", true
+	}
+	return "🛁 Squeaky clean!", true
+}
+
+// GetMessDisplay returns a pile of mess that grows as the environment gets dirtier
+func GetMessDisplay(p Pet) string {
+	if p.Dead || p.Cleanliness >= MessyThreshold {
+		return ""
+	}
+	piles := min((MessyThreshold-p.Cleanliness-1)/20+1, 3)
+	return strings.Repeat(StatusEmojiDirty, piles)
+}
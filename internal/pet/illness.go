@@ -0,0 +1,207 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// Disease type constants
+const (
+	DiseaseCold         = "cold"
+	DiseaseUpsetStomach = "upset_stomach"
+	DiseaseFever        = "fever"
+)
+
+// DiseaseDefinition describes a named illness and how to treat it
+type DiseaseDefinition struct {
+	Type         string
+	Name         string
+	Emoji        string
+	Incubation   time.Duration  // Time after catching it before symptoms show
+	Duration     time.Duration  // Time symptoms last if left untreated
+	Symptoms     map[string]int // stat name -> loss per hour while symptomatic
+	Doses        int            // Medicine doses needed to cure it
+	DoseInterval time.Duration  // Minimum time between doses
+}
+
+// Disease is an illness the pet has caught
+type Disease struct {
+	Type       string    `json:"type"`
+	CaughtAt   time.Time `json:"caught_at"`
+	SymptomsAt time.Time `json:"symptoms_at"`
+	RecoversAt time.Time `json:"recovers_at"` // When it clears up on its own
+	Diagnosed  bool      `json:"diagnosed,omitempty"`
+	DosesGiven int       `json:"doses_given,omitempty"`
+}
+
+// GetDiseaseDefinitions returns every illness a pet can catch
+func GetDiseaseDefinitions() []DiseaseDefinition {
+	return []DiseaseDefinition{
+		{
+			Type:         DiseaseCold,
+			Name:         "Cold",
+			Emoji:        "🤧",
+			Incubation:   2 * time.Hour,
+			Duration:     24 * time.Hour,
+			Symptoms:     map[string]int{"energy": 2, "happiness": 1},
+			Doses:        2,
+			DoseInterval: 2 * time.Hour,
+		},
+		{
+			Type:         DiseaseUpsetStomach,
+			Name:         "Upset Stomach",
+			Emoji:        "🤮",
+			Incubation:   1 * time.Hour,
+			Duration:     12 * time.Hour,
+			Symptoms:     map[string]int{"hunger": 3, "health": 1},
+			Doses:        2,
+			DoseInterval: 1 * time.Hour,
+		},
+		{
+			Type:         DiseaseFever,
+			Name:         "Fever",
+			Emoji:        "🤒",
+			Duration:     36 * time.Hour,
+			Symptoms:     map[string]int{"health": 2, "energy": 2},
+			Doses:        3,
+			DoseInterval: 4 * time.Hour,
+		},
+	}
+}
+
+// GetDiseaseDefinition returns the definition for a disease type
+func GetDiseaseDefinition(diseaseType string) *DiseaseDefinition {
+	for _, def := range GetDiseaseDefinitions() {
+		if def.Type == diseaseType {
+			return &def
+		}
+	}
+	return nil
+}
+
+// CatchDisease infects the pet with a disease unless it is already ill.
+// Returns true if the pet caught it.
+func (p *Pet) CatchDisease(diseaseType string) bool {
+	def := GetDiseaseDefinition(diseaseType)
+	if def == nil || p.Disease != nil || p.Dead {
+		return false
+	}
+
+	now := TimeNow()
+	p.Disease = &Disease{
+		Type:       def.Type,
+		CaughtAt:   now,
+		SymptomsAt: now.Add(def.Incubation),
+		RecoversAt: now.Add(def.Incubation + def.Duration),
+	}
+	p.Illness = def.Incubation == 0
+	log.Printf("Pet caught a %s (symptoms in %v)", def.Name, def.Incubation)
+	return true
+}
+
+// CatchDiseaseWithChance rolls to infect the pet, adjusted by its traits, weight and bond
+func (p *Pet) CatchDiseaseWithChance(diseaseType string, chance float64) bool {
+	chance *= p.GetTraitModifier("illness_chance") * p.GetWeightModifier("illness_chance")
+	if p.Bond >= IllnessResistanceBond {
+		bondReduction := 1.0 - (float64(p.Bond-IllnessResistanceBond) / float64(MaxBond-IllnessResistanceBond) * 0.5)
+		chance *= bondReduction
+	}
+	if RandFloat64() < chance {
+		return p.CatchDisease(diseaseType)
+	}
+	return false
+}
+
+// CureDisease clears any illness
+func (p *Pet) CureDisease() {
+	p.Disease = nil
+	p.Illness = false
+}
+
+// ensureDisease gives an untyped illness (from older saves) a name
+func (p *Pet) ensureDisease() {
+	if p.Illness && p.Disease == nil {
+		p.CatchDisease(DiseaseFever)
+		p.Illness = true
+	}
+}
+
+// UpdateDisease progresses the pet's illness over the time between from and to:
+// symptoms appear after incubation, drain stats while they last, and the illness
+// clears up on its own once it has run its course.
+func (p *Pet) UpdateDisease(quiet QuietSchedule, from, to time.Time) {
+	p.ensureDisease()
+	if p.Disease == nil {
+		return
+	}
+	def := GetDiseaseDefinition(p.Disease.Type)
+	if def == nil {
+		p.CureDisease()
+		return
+	}
+
+	if !p.Illness && !to.Before(p.Disease.SymptomsAt) {
+		p.Illness = true
+		log.Printf("%s symptoms appeared", def.Name)
+	}
+
+	start := from
+	if p.Disease.SymptomsAt.After(start) {
+		start = p.Disease.SymptomsAt
+	}
+	end := to
+	if p.Disease.RecoversAt.Before(end) {
+		end = p.Disease.RecoversAt
+	}
+	// Symptoms ease off with the sitter and pause during quiet hours, like every other stat
+	if hours := p.decayHours(quiet, start, end); hours > 0 {
+		p.applySymptoms(def, hours)
+	}
+
+	if !to.Before(p.Disease.RecoversAt) {
+		log.Printf("Pet recovered from %s on its own", def.Name)
+		p.CureDisease()
+	}
+}
+
+// applySymptoms drains the stats a disease affects for the given number of hours
+func (p *Pet) applySymptoms(def *DiseaseDefinition, hours float64) {
+	for stat, rate := range def.Symptoms {
+		loss := int(hours * float64(rate))
+		switch stat {
+		case "hunger":
+			p.Hunger = max(p.Hunger-loss, MinStat)
+		case "happiness":
+			p.Happiness = max(p.Happiness-loss, MinStat)
+		case "energy":
+			p.Energy = max(p.Energy-loss, MinStat)
+		case "health":
+			p.Health = max(p.Health-loss, MinStat)
+		}
+	}
+}
+
+// GetIllnessDisplay describes the pet's illness and treatment progress. Illnesses
+// still incubating only show once a checkup has found them.
+func GetIllnessDisplay(p Pet) string {
+	if p.Disease != nil && p.Disease.Diagnosed && !p.Illness {
+		if def := GetDiseaseDefinition(p.Disease.Type); def != nil {
+			return fmt.Sprintf("%s %s (incubating, %d/%d doses)", def.Emoji, def.Name, p.Disease.DosesGiven, def.Doses)
+		}
+	}
+	if !p.Illness {
+		return "No"
+	}
+	if p.Disease == nil {
+		return "Yes"
+	}
+	def := GetDiseaseDefinition(p.Disease.Type)
+	if def == nil {
+		return "Yes"
+	}
+	if !p.Disease.Diagnosed {
+		return "❓ Needs a checkup"
+	}
+	return fmt.Sprintf("%s %s (%d/%d doses)", def.Emoji, def.Name, p.Disease.DosesGiven, def.Doses)
+}
@@ -0,0 +1,218 @@
+package pet
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// CheatDeathCause is the cause of death for pets killed from the cheat menu, which never reach the memorial
+const CheatDeathCause = "Cheats"
+
+// MemorialEntry records a deceased pet
+type MemorialEntry struct {
+	Name          string    `json:"name"`
+	Born          time.Time `json:"born"`
+	Died          time.Time `json:"died"`
+	LifespanHours int       `json:"lifespan_hours"`
+	FinalForm     PetForm   `json:"final_form"`
+	CauseOfDeath  string    `json:"cause_of_death"`
+	Traits        []string  `json:"traits,omitempty"`
+	Chronotype    string    `json:"chronotype,omitempty"`
+	Bond          int       `json:"bond"`
+	Lineage       []PetForm `json:"lineage,omitempty"`
+	NotableEvents []string  `json:"notable_events,omitempty"`
+	Generation    int       `json:"generation,omitempty"`
+	Parent        string    `json:"parent,omitempty"`
+}
+
+// Memorial holds every archived pet, oldest first
+type Memorial struct {
+	Pets []MemorialEntry `json:"pets"`
+}
+
+// MemorialRecords holds aggregate records across all archived pets
+type MemorialRecords struct {
+	TotalPets       int
+	AverageLifespan int
+	LongestLived    *MemorialEntry
+	MostBonded      *MemorialEntry
+	MostCommonCause string
+}
+
+// GetMemorialPath returns the path to the memorial file, next to the pet state file
+func GetMemorialPath() string {
+	return filepath.Join(filepath.Dir(GetConfigPath()), "memorial.json")
+}
+
+// LoadMemorial loads the memorial, returning an empty one if none exists
+func LoadMemorial() Memorial {
+	var m Memorial
+	data, err := os.ReadFile(GetMemorialPath())
+	if err != nil {
+		return m
+	}
+	if err := json.Unmarshal(data, &m); err != nil {
+		log.Printf("Error loading memorial: %v", err)
+	}
+	return m
+}
+
+// SaveMemorial writes the memorial to disk
+func SaveMemorial(m Memorial) {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		log.Printf("Error saving memorial: %v", err)
+		return
+	}
+	if err := os.WriteFile(GetMemorialPath(), data, 0644); err != nil {
+		log.Printf("Error writing memorial: %v", err)
+	}
+}
+
+// NewMemorialEntry builds a memorial entry from a deceased pet
+func NewMemorialEntry(p Pet) MemorialEntry {
+	entry := MemorialEntry{
+		Name:          p.Name,
+		Died:          p.LastSaved,
+		LifespanHours: p.Age,
+		FinalForm:     p.Form,
+		CauseOfDeath:  p.CauseOfDeath,
+		Chronotype:    p.Chronotype,
+		Bond:          p.Bond,
+		Generation:    p.Generation(),
+	}
+	if parent := p.Parent(); parent != nil {
+		entry.Parent = parent.Name
+	}
+	if len(p.Logs) > 0 {
+		entry.Born = p.Logs[0].Time
+	}
+	// Pets that died before the time of death was recorded fall back to when they were last saved
+	if p.DiedAt != nil {
+		entry.Died = *p.DiedAt
+		entry.LifespanHours = p.AgeAtDeath
+	}
+	if entry.CauseOfDeath == "" {
+		entry.CauseOfDeath = "Unknown"
+	}
+
+	for _, trait := range p.Traits {
+		entry.Traits = append(entry.Traits, trait.Name)
+	}
+
+	if len(p.EvolutionHistory) > 0 {
+		entry.Lineage = append(entry.Lineage, p.EvolutionHistory[0].From)
+		for _, record := range p.EvolutionHistory {
+			entry.Lineage = append(entry.Lineage, record.To)
+		}
+	}
+
+	entry.NotableEvents = notableEvents(p)
+	return entry
+}
+
+// notableEvents summarizes the highlights of a pet's life
+func notableEvents(p Pet) []string {
+	var events []string
+	for _, record := range p.EvolutionHistory {
+		form := Pet{Form: record.To}
+		events = append(events, fmt.Sprintf("Became %s at %d%% care", form.GetFormName(), record.CareQuality))
+	}
+	for _, trick := range p.Tricks {
+		if trick.Proficiency >= TrickMasteredThreshold {
+			events = append(events, "Mastered "+trick.Name)
+		}
+	}
+
+	responded := 0
+	counts := make(map[string]int)
+	for _, entry := range p.EventLog {
+		if !entry.WasIgnored {
+			responded++
+			counts[entry.Type]++
+		}
+	}
+	if responded > 0 {
+		favorite := ""
+		for _, def := range GetEventDefinitions() {
+			if counts[def.Type] > 0 && (favorite == "" || counts[def.Type] > counts[favorite]) {
+				favorite = def.Type
+			}
+		}
+		// Logged events may no longer be defined, leaving no favorite to name
+		if def := GetEventDefinition(favorite); def != nil {
+			events = append(events, fmt.Sprintf("Shared %d moments, most often %s %s", responded, def.Emoji, def.Message))
+		} else {
+			events = append(events, fmt.Sprintf("Shared %d moments", responded))
+		}
+	}
+	return events
+}
+
+// ArchivePet adds a deceased pet to the memorial. Pets already archived or killed with
+// cheats are skipped, so it is safe to call more than once. Returns true if the pet was added.
+func ArchivePet(p Pet) bool {
+	if !p.Dead || p.CauseOfDeath == CheatDeathCause {
+		return false
+	}
+
+	entry := NewMemorialEntry(p)
+	m := LoadMemorial()
+	for _, existing := range m.Pets {
+		if existing.Name == entry.Name && existing.Born.Equal(entry.Born) {
+			return false
+		}
+	}
+
+	m.Pets = append(m.Pets, entry)
+	SaveMemorial(m)
+	log.Printf("Archived %s to the memorial (%s)", entry.Name, entry.CauseOfDeath)
+	return true
+}
+
+// Records computes aggregate records across every archived pet
+func (m Memorial) Records() MemorialRecords {
+	records := MemorialRecords{TotalPets: len(m.Pets)}
+	if len(m.Pets) == 0 {
+		return records
+	}
+
+	totalLifespan := 0
+	causes := make(map[string]int)
+	for i := range m.Pets {
+		entry := &m.Pets[i]
+		totalLifespan += entry.LifespanHours
+		causes[entry.CauseOfDeath]++
+
+		if records.LongestLived == nil || entry.LifespanHours > records.LongestLived.LifespanHours {
+			records.LongestLived = entry
+		}
+		if records.MostBonded == nil || entry.Bond > records.MostBonded.Bond {
+			records.MostBonded = entry
+		}
+		if records.MostCommonCause == "" || causes[entry.CauseOfDeath] > causes[records.MostCommonCause] {
+			records.MostCommonCause = entry.CauseOfDeath
+		}
+	}
+	records.AverageLifespan = totalLifespan / len(m.Pets)
+	return records
+}
+
+// GetLineageDisplay returns the archived pet's evolution path as a chain of form emojis
+func (e MemorialEntry) GetLineageDisplay() string {
+	final := Pet{Form: e.FinalForm}
+	if len(e.Lineage) == 0 {
+		return final.GetFormEmoji() + " " + final.GetFormName()
+	}
+	var chain []string
+	for _, form := range e.Lineage {
+		p := Pet{Form: form}
+		chain = append(chain, p.GetFormEmoji())
+	}
+	return strings.Join(chain, " → ")
+}
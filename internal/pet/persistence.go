@@ -3,6 +3,7 @@ package pet
 import (
 	"encoding/json"
 	"log"
+	"math"
 	"os"
 	"path/filepath"
 	"time"
@@ -57,30 +58,33 @@ func NewPet(testCfg *TestConfig) Pet {
 			birthTime = now
 		}
 		p = Pet{
-			Name:      DefaultPetName,
-			Hunger:    testCfg.InitialHunger,
-			Happiness: testCfg.InitialHappiness,
-			Energy:    testCfg.InitialEnergy,
-			Health:    testCfg.Health,
-			Age:       0,
-			LifeStage: 0,
-			Sleeping:  testCfg.IsSleeping,
-			LastSaved: birthTime,
-			Illness:   testCfg.Illness,
+			Name:        DefaultPetName,
+			Hunger:      testCfg.InitialHunger,
+			Happiness:   testCfg.InitialHappiness,
+			Energy:      testCfg.InitialEnergy,
+			Health:      testCfg.Health,
+			Cleanliness: MaxStat,
+			Age:         0,
+			LifeStage:   0,
+			Sleeping:    testCfg.IsSleeping,
+			LastSaved:   birthTime,
+			Illness:     testCfg.Illness,
 		}
 	} else {
 		p = Pet{
-			Name:      DefaultPetName,
-			Hunger:    MaxStat,
-			Happiness: MaxStat,
-			Energy:    MaxStat,
-			Health:    MaxStat,
-			Age:       0,
-			LifeStage: 0,
-			Form:      FormBaby,
-			Sleeping:  false,
-			LastSaved: now,
-			Illness:   false,
+			Name:        DefaultPetName,
+			Hunger:      MaxStat,
+			Happiness:   MaxStat,
+			Energy:      MaxStat,
+			Health:      MaxStat,
+			Cleanliness: MaxStat,
+			Age:         0,
+			LifeStage:   0,
+			Form:        FormBaby,
+			Sleeping:    false,
+			LastSaved:   now,
+			Illness:     false,
+			TimeZone:    localZoneName(),
 		}
 	}
 
@@ -95,6 +99,9 @@ func NewPet(testCfg *TestConfig) Pet {
 		p.StatCheckpoints = make(map[string][]StatCheck)
 	}
 
+	// Stock the pantry with a day's rations
+	p.RestockPantry(birthTime)
+
 	// Assign random chronotype at birth
 	if p.Chronotype == "" {
 		p.Chronotype = AssignRandomChronotype()
@@ -112,7 +119,7 @@ func NewPet(testCfg *TestConfig) Pet {
 		log.Printf("Initialized bond at %d", InitialBond)
 	}
 
-	p.LastStatus = GetStatus(p)
+	p.LastStatus = statusEmoji(p)
 	// Add initial log entry with birth time
 	p.Logs = []LogEntry{{
 		Time:      birthTime,
@@ -138,100 +145,77 @@ func LoadState() Pet {
 		return NewPet(nil)
 	}
 
-	var p Pet
+	p := Pet{Cleanliness: legacyCleanliness}
 	if err := json.Unmarshal(data, &p); err != nil {
 		log.Printf("Error loading state: %v. Creating new pet.", err)
 		return NewPet(nil)
 	}
+	if p.Cleanliness == legacyCleanliness {
+		p.migrateHygiene()
+	}
+	p.migrateTraits()
 
 	// Update stats based on elapsed time and check for death
 	now := TimeNow()
 	log.Printf("last saved: %s\n", p.LastSaved.UTC())
 	elapsed := now.Sub(p.LastSaved.UTC())
 	log.Printf("elapsed %f\n", elapsed.Seconds())
-	elapsedHours := elapsed.Hours()
+
+	// Quiet hours and the calendar are read once and shared by everything below
+	quiet := p.LoadQuietSchedule()
+
+	// Quiet hours pause the critical countdown
+	if quietHours := p.QuietHoursBetween(quiet, p.LastSaved, now); quietHours > 0 && p.CriticalStartTime != nil {
+		shifted := p.CriticalStartTime.Add(time.Duration(quietHours * float64(time.Hour)))
+		if shifted.After(now) {
+			shifted = now
+		}
+		p.CriticalStartTime = &shifted
+	}
 
 	// Store current status before updates
 	oldStatus := p.LastStatus
 	if oldStatus == "" {
-		oldStatus = GetStatus(p)
+		oldStatus = statusEmoji(p)
 	}
 
-	// Update age and life stage
-	birthTime := p.Logs[0].Time
-	p.Age = int(now.Sub(birthTime).Hours())
-
-	// Calculate life stage based on age and handle evolution
-	oldLifeStage := p.LifeStage
-	if p.Age < AgeStageThresholds {
-		p.LifeStage = 0 // Baby
-	} else if p.Age < 2*AgeStageThresholds {
-		p.LifeStage = 1 // Child
-	} else {
-		p.LifeStage = 2 // Adult
-	}
-
-	// Handle evolution when life stage changes
-	if oldLifeStage != p.LifeStage && p.LifeStage > 0 {
-		p.Evolve(p.LifeStage)
-	}
+	// Update age and life stage, evolving through every stage passed since the last load
+	p.UpdateLifeStage(now)
 
 	// Check death condition first
 	if p.Dead {
 		return p
 	}
 
-	// Calculate hunger decrease with trait modifiers
-	hungerRate := float64(HungerDecreaseRate)
-	if p.Sleeping {
-		hungerRate = float64(SleepingHungerRate)
-	}
-	hungerRate *= p.GetTraitModifier("hunger_decay")
-	hungerLoss := int(elapsedHours * hungerRate)
-	p.Hunger = max(p.Hunger-hungerLoss, MinStat)
-
-	// Apply chronotype-based multipliers
-	currentHour := now.Local().Hour()
-	isActive := IsActiveHours(&p, currentHour)
-
-	if !p.Sleeping {
-		// Energy decreases when awake
-		energyMult := 1.0
-		if !isActive {
-			energyMult = OutsideActiveEnergyMult
-		}
-		energyMult *= p.GetTraitModifier("energy_decay")
-		energyLoss := int((elapsedHours / 2.0) * float64(EnergyDecreaseRate) * energyMult)
-		p.Energy = max(p.Energy-energyLoss, MinStat)
-	} else {
-		// Energy recovers while sleeping
-		recoveryMult := 1.0
-		if !isActive {
-			recoveryMult = PreferredSleepRecoveryMult
-		}
-		exactGain := elapsedHours * float64(EnergyRecoveryRate) * recoveryMult
-		p.FractionalEnergy += exactGain
-		wholeGain := int(p.FractionalEnergy)
-		p.FractionalEnergy -= float64(wholeGain)
-		p.Energy = min(p.Energy+wholeGain, MaxStat)
-	}
+	p.RestockPantry(now)
+	p.AdaptSchedule(now)
 
-	// Update happiness if stats are low
-	if p.Hunger < LowStatThreshold || p.Energy < LowStatThreshold {
-		happinessRate := float64(HappinessDecreaseRate) * p.GetTraitModifier("happiness_decay")
-		happinessLoss := int(elapsedHours * happinessRate)
-		p.Happiness = max(p.Happiness-happinessLoss, MinStat)
+	// A trip that ended since the last load is settled first, so the sitter looks after the pet for the
+	// whole stay and only the time since the return decays at the full rate
+	decayFrom := p.LastSaved
+	if p.Vacation != nil && p.Vacation.Until.After(p.LastSaved) && !now.Before(p.Vacation.Until) {
+		p.decayStats(p.decayHours(quiet, p.LastSaved, p.Vacation.Until), p.Vacation.Until)
+		p.applySitterCare()
+		decayFrom = p.Vacation.Until
 	}
+	elapsedHours := p.decayHours(quiet, decayFrom, now)
+	p.decayStats(elapsedHours, now)
 
 	// Update bond from neglect
-	if len(p.LastInteractions) > 0 {
-		var mostRecent = p.LastInteractions[0].Time
-		for _, interaction := range p.LastInteractions {
-			if interaction.Time.After(mostRecent) {
-				mostRecent = interaction.Time
-			}
+	// Vet visits don't count as attention, so a refused pill every few hours can't hold off neglect
+	var mostRecent time.Time
+	for _, interaction := range p.LastInteractions {
+		if interaction.Type == "medicine" || interaction.Type == "checkup" {
+			continue
+		}
+		if interaction.Time.After(mostRecent) {
+			mostRecent = interaction.Time
 		}
-		hoursSinceInteraction := now.Sub(mostRecent).Hours()
+	}
+	if !mostRecent.IsZero() {
+		// Time away on vacation or during quiet hours doesn't count as neglect
+		hoursSinceInteraction := now.Sub(mostRecent).Hours() - p.VacationOverlapHours(mostRecent, now) -
+			p.QuietHoursBetween(quiet, mostRecent, now)
 
 		if hoursSinceInteraction > BondDecayThreshold {
 			excessHours := hoursSinceInteraction - BondDecayThreshold
@@ -243,18 +227,26 @@ func LoadState() Pet {
 		}
 	}
 
-	// Check for random illness when health is low
-	if p.Health < 50 && !p.Illness {
-		adjustedIllnessChance := IllnessChance * p.GetTraitModifier("illness_chance")
-		if p.Bond >= IllnessResistanceBond {
-			bondReduction := 1.0 - (float64(p.Bond-IllnessResistanceBond) / float64(MaxBond-IllnessResistanceBond) * 0.5)
-			adjustedIllnessChance *= bondReduction
-		}
-		if RandFloat64() < adjustedIllnessChance {
-			p.Illness = true
+	// Progress any illness the pet already has
+	p.UpdateDisease(quiet, p.LastSaved, now)
+
+	// Check for random illness when health is low or the environment is dirty
+	if p.Disease == nil && (p.Health < 50 || p.IsDirty()) {
+		chance := IllnessChance
+		disease := DiseaseFever
+		if p.IsDirty() {
+			chance *= DirtyIllnessMultiplier
+			disease = DiseaseUpsetStomach
 		}
-	} else if p.Health >= 50 {
-		p.Illness = false
+		// The chance is per hour, so how often the pet is loaded doesn't change the risk
+		chance = 1 - math.Pow(1-math.Min(chance, 1), elapsedHours)
+		p.CatchDiseaseWithChance(disease, chance)
+	}
+
+	// A dirty environment wears down health
+	if p.IsDirty() {
+		dirtyLoss := int(elapsedHours * DirtyHealthDecayRate)
+		p.Health = max(p.Health-dirtyLoss, MinStat)
 	}
 
 	// Health decreases when any stat is critically low
@@ -268,6 +260,12 @@ func LoadState() Pet {
 		p.Health = max(p.Health-healthLoss, MinStat)
 	}
 
+	// The pet sitter makes sure nothing gets too low while the owner is away
+	onVacation := p.OnVacation(now)
+	if onVacation {
+		p.applySitterCare()
+	}
+
 	// Check if any critical stat is below threshold
 	inCriticalState := p.Health <= 20 || p.Hunger < 10 ||
 		p.Happiness < 10 || p.Energy < 10
@@ -279,23 +277,21 @@ func LoadState() Pet {
 		}
 
 		if now.Sub(*p.CriticalStartTime) > DeathTimeThreshold {
-			p.Dead = true
-			p.CauseOfDeath = "Neglect"
-
+			cause := "Neglect"
 			if p.Hunger <= 0 {
-				p.CauseOfDeath = "Starvation"
+				cause = "Starvation"
 			} else if p.Illness {
-				p.CauseOfDeath = "Sickness"
+				cause = "Sickness"
 			}
+			p.Die(cause, now)
 		}
 	} else {
 		p.CriticalStartTime = nil
 	}
 
 	// Check for natural death from old age
-	if p.Age >= MinNaturalLifespan && RandFloat64() < float64(p.Age-MinNaturalLifespan)/1000 {
-		p.Dead = true
-		p.CauseOfDeath = "Old Age"
+	if !onVacation && p.Age >= MinNaturalLifespan && RandFloat64() < float64(p.Age-MinNaturalLifespan)/1000 {
+		p.Die("Old Age", now)
 	}
 
 	// Apply autonomous behavior
@@ -303,21 +299,111 @@ func LoadState() Pet {
 		ApplyAutonomousBehavior(&p)
 	}
 
-	// Trigger random life events
-	TriggerRandomEvent(&p)
+	// Trigger random life events while the pet is home
+	if !onVacation {
+		TriggerRandomEvent(&p, quiet)
+	}
+
+	// Vacations end on their own once the return date passes
+	if p.Vacation != nil && !now.Before(p.Vacation.Until) {
+		p.EndVacation(now)
+	}
+
+	// Track care quality for evolution even when the TUI isn't open
+	if !p.Dead {
+		p.RecordStatCheckpointIfDue()
+	}
+
+	p.UpdateQuests(now)
 
 	p.LastSaved = now
 	return p
 }
 
+// Die marks the pet as dead, recording when and at what age for the memorial
+func (p *Pet) Die(cause string, now time.Time) {
+	p.Dead = true
+	p.CauseOfDeath = cause
+	p.DiedAt = &now
+	p.AgeAtDeath = p.AgeAt(now)
+}
+
+// decayHours returns how many hours of full-rate decay a span is worth: time with the pet sitter only counts
+// for a fraction, and quiet hours not at all
+func (p *Pet) decayHours(quiet QuietSchedule, from, to time.Time) float64 {
+	hours := to.Sub(from).Hours()
+	if vacationHours := p.VacationOverlapHours(from, to); vacationHours > 0 {
+		hours -= vacationHours * (1 - VacationDecayMult)
+	}
+	hours -= p.QuietHoursBetween(quiet, from, to)
+	if hours < 0 {
+		hours = 0
+	}
+	return hours
+}
+
+// decayStats drains hunger, energy, happiness, weight and cleanliness over the given hours
+func (p *Pet) decayStats(elapsedHours float64, now time.Time) {
+	// Calculate hunger decrease with trait modifiers
+	hungerRate := float64(HungerDecreaseRate)
+	if p.Sleeping {
+		hungerRate = float64(SleepingHungerRate)
+	}
+	hungerRate *= p.GetTraitModifier("hunger_decay")
+	hungerLoss := int(elapsedHours * hungerRate)
+	p.Hunger = max(p.Hunger-hungerLoss, MinStat)
+
+	// Apply chronotype-based multipliers
+	isActive := p.IsActiveAt(now)
+
+	if !p.Sleeping {
+		// Energy decreases when awake
+		energyMult := 1.0
+		if !isActive {
+			energyMult = OutsideActiveEnergyMult
+		}
+		energyMult *= p.GetTraitModifier("energy_decay") * p.GetWeightModifier("energy_decay")
+		energyLoss := int((elapsedHours / 2.0) * float64(EnergyDecreaseRate) * energyMult)
+		p.Energy = max(p.Energy-energyLoss, MinStat)
+	} else {
+		// Energy recovers while sleeping
+		recoveryMult := 1.0
+		if !isActive {
+			recoveryMult = PreferredSleepRecoveryMult
+		}
+		exactGain := elapsedHours * float64(EnergyRecoveryRate) * recoveryMult
+		p.FractionalEnergy += exactGain
+		wholeGain := int(p.FractionalEnergy)
+		p.FractionalEnergy -= float64(wholeGain)
+		p.Energy = min(p.Energy+wholeGain, MaxStat)
+	}
+
+	// Update happiness if stats are low
+	if p.Hunger < LowStatThreshold || p.Energy < LowStatThreshold {
+		happinessRate := float64(HappinessDecreaseRate) * p.GetTraitModifier("happiness_decay")
+		happinessLoss := int(elapsedHours * happinessRate)
+		p.Happiness = max(p.Happiness-happinessLoss, MinStat)
+	}
+
+	// Hunger and metabolism move weight
+	p.UpdateWeight(elapsedHours)
+
+	// Cleanliness decays over time, slower while sleeping
+	cleanlinessRate := float64(CleanlinessDecreaseRate)
+	if p.Sleeping {
+		cleanlinessRate = float64(SleepingCleanlinessRate)
+	}
+	cleanlinessLoss := int(elapsedHours * cleanlinessRate)
+	p.Cleanliness = max(p.Cleanliness-cleanlinessLoss, MinStat)
+}
+
 // SaveState saves the pet's state to file
 func SaveState(p *Pet) {
 	now := TimeNow()
-	birthTime := p.Logs[0].Time
-	p.Age = int(now.Sub(birthTime).Hours())
+	p.Age = p.AgeAt(now)
 	p.LastSaved = now
 
-	currentStatus := GetStatus(*p)
+	currentStatus := statusEmoji(*p)
 	if p.LastStatus == "" {
 		p.LastStatus = currentStatus
 	}
@@ -336,6 +422,8 @@ func SaveState(p *Pet) {
 		p.LastStatus = currentStatus
 	}
 
+	BankCoins(p)
+
 	data, err := json.MarshalIndent(p, "", "  ")
 	if err != nil {
 		log.Printf("Error saving state: %v", err)
@@ -344,13 +432,20 @@ func SaveState(p *Pet) {
 	if err := os.WriteFile(GetConfigPath(), data, 0644); err != nil {
 		log.Printf("Error writing state: %v", err)
 	}
+
+	// Milestones count on every save, including the tmux status updates
+	CheckAchievements(p)
+
+	// Remember pets that died since the last save
+	if p.Dead {
+		ArchivePet(*p)
+	}
 }
 
 // ApplyAutonomousBehavior makes the pet act on its own based on current state
 func ApplyAutonomousBehavior(p *Pet) {
 	now := TimeNow()
-	currentHour := now.Local().Hour()
-	isActive := IsActiveHours(p, currentHour)
+	isActive := p.IsActiveAt(now)
 
 	// Determine auto-sleep threshold based on chronotype
 	sleepThreshold := AutoSleepThreshold
@@ -394,42 +489,17 @@ func ApplyAutonomousBehavior(p *Pet) {
 		p.Mood = "normal"
 	}
 	if p.MoodExpiresAt == nil || now.After(*p.MoodExpiresAt) {
-		var newMood string
-		roll := RandFloat64()
-
+		var options []moodWeight
 		if p.Energy < DrowsyThreshold {
-			if roll < 0.6 {
-				newMood = "lazy"
-			} else if roll < 0.8 {
-				newMood = "needy"
-			} else {
-				newMood = "normal"
-			}
+			options = []moodWeight{{"lazy", 0.6}, {"needy", 0.2}, {"normal", 0.2}}
 		} else if p.Happiness < BoredThreshold {
-			if roll < 0.5 {
-				newMood = "needy"
-			} else if roll < 0.7 {
-				newMood = "playful"
-			} else {
-				newMood = "normal"
-			}
+			options = []moodWeight{{"needy", 0.5}, {"playful", 0.2}, {"normal", 0.3}}
 		} else if p.Hunger < HungryThreshold {
-			if roll < 0.5 {
-				newMood = "needy"
-			} else {
-				newMood = "normal"
-			}
+			options = []moodWeight{{"needy", 0.5}, {"normal", 0.5}}
 		} else {
-			if roll < 0.6 {
-				newMood = "normal"
-			} else if roll < 0.8 {
-				newMood = "playful"
-			} else if roll < 0.9 {
-				newMood = "lazy"
-			} else {
-				newMood = "needy"
-			}
+			options = []moodWeight{{"normal", 0.6}, {"playful", 0.2}, {"lazy", 0.1}, {"needy", 0.1}}
 		}
+		newMood := pickMood(p, options)
 
 		p.Mood = newMood
 		moodDuration := (2 + int(RandFloat64()*2)) * int(1)
@@ -438,3 +508,29 @@ func ApplyAutonomousBehavior(p *Pet) {
 		log.Printf("Pet mood changed to: %s (expires in %d hours)", newMood, moodDuration)
 	}
 }
+
+// moodWeight is a mood and its base chance of being picked
+type moodWeight struct {
+	mood   string
+	weight float64
+}
+
+// pickMood selects a mood from weighted options, adjusted by the pet's traits
+func pickMood(p *Pet, options []moodWeight) string {
+	total := 0.0
+	weights := make([]float64, len(options))
+	for i, option := range options {
+		weights[i] = option.weight * p.GetMoodWeightModifier(option.mood)
+		total += weights[i]
+	}
+
+	roll := RandFloat64() * total
+	cumulative := 0.0
+	for i, option := range options {
+		cumulative += weights[i]
+		if roll < cumulative {
+			return option.mood
+		}
+	}
+	return options[len(options)-1].mood
+}
@@ -23,31 +23,35 @@ type LogEntry struct {
 // Trait represents a personality characteristic that affects pet behavior
 type Trait struct {
 	Name      string             `json:"name"`
-	Category  string             `json:"category"`  // "temperament", "appetite", "sociability", "constitution"
+	Category  string             `json:"category"`  // One of GetTraitCategories()
 	Modifiers map[string]float64 `json:"modifiers"` // stat_name -> multiplier
 }
 
 // Interaction represents a player action with the pet
 type Interaction struct {
-	Type string    `json:"type"` // "feed", "play", "medicine"
-	Time time.Time `json:"time"`
+	Type     string    `json:"type"` // "feed", "play", "medicine", "checkup", "clean", "train", "perform"
+	Time     time.Time `json:"time"`
+	Food     string    `json:"food,omitempty"`     // Food type for "feed" interactions
+	Activity string    `json:"activity,omitempty"` // Activity type for "play" interactions
 }
 
 // CareQuality tracks average stats during a life stage
 type CareQuality struct {
-	AvgHunger    int `json:"avg_hunger"`
-	AvgHappiness int `json:"avg_happiness"`
-	AvgEnergy    int `json:"avg_energy"`
-	AvgHealth    int `json:"avg_health"`
+	AvgHunger      int `json:"avg_hunger"`
+	AvgHappiness   int `json:"avg_happiness"`
+	AvgEnergy      int `json:"avg_energy"`
+	AvgHealth      int `json:"avg_health"`
+	AvgCleanliness int `json:"avg_cleanliness"`
 }
 
 // StatCheck records stats at a point in time for averaging
 type StatCheck struct {
-	Time      time.Time `json:"time"`
-	Hunger    int       `json:"hunger"`
-	Happiness int       `json:"happiness"`
-	Energy    int       `json:"energy"`
-	Health    int       `json:"health"`
+	Time        time.Time `json:"time"`
+	Hunger      int       `json:"hunger"`
+	Happiness   int       `json:"happiness"`
+	Energy      int       `json:"energy"`
+	Health      int       `json:"health"`
+	Cleanliness int       `json:"cleanliness"`
 }
 
 // Event represents a life event happening to the pet
@@ -56,6 +60,7 @@ type Event struct {
 	StartTime time.Time `json:"start_time"`
 	ExpiresAt time.Time `json:"expires_at"`
 	Responded bool      `json:"responded"`
+	Trick     string    `json:"trick,omitempty"` // Trick a learned-trick event is about
 }
 
 // EventLogEntry records past events for the pet's "memory"
@@ -72,19 +77,25 @@ type Pet struct {
 	Happiness          int                    `json:"happiness"`
 	Energy             int                    `json:"energy"`
 	Health             int                    `json:"health"`
+	Cleanliness        int                    `json:"cleanliness"`
+	Weight             int                    `json:"weight,omitempty"` // Distance from ideal weight
 	Age                int                    `json:"age"`
 	LifeStage          int                    `json:"stage"`
 	Form               PetForm                `json:"form"`
 	Sleeping           bool                   `json:"sleeping"`
 	Dead               bool                   `json:"dead"`
 	CauseOfDeath       string                 `json:"cause_of_death,omitempty"`
+	DiedAt             *time.Time             `json:"died_at,omitempty"`
+	AgeAtDeath         int                    `json:"age_at_death,omitempty"` // Age keeps counting after death, so it's kept here
 	LastSaved          time.Time              `json:"last_saved"`
 	CriticalStartTime  *time.Time             `json:"critical_start_time,omitempty"`
-	Illness            bool                   `json:"illness"`
+	Illness            bool                   `json:"illness"` // Showing symptoms
+	Disease            *Disease               `json:"disease,omitempty"`
 	LastStatus         string                 `json:"last_status,omitempty"`
 	Logs               []LogEntry             `json:"logs,omitempty"`
 	CareQualityHistory map[int]CareQuality    `json:"care_quality_history,omitempty"`
 	StatCheckpoints    map[string][]StatCheck `json:"stat_checkpoints,omitempty"`
+	EvolutionHistory   []EvolutionRecord      `json:"evolution_history,omitempty"`
 
 	// Autonomous behavior fields
 	Mood          string     `json:"mood,omitempty"`
@@ -92,21 +103,58 @@ type Pet struct {
 	AutoSleepTime *time.Time `json:"auto_sleep_time,omitempty"`
 
 	// Life events system
-	CurrentEvent *Event          `json:"current_event,omitempty"`
-	EventLog     []EventLogEntry `json:"event_log,omitempty"`
+	CurrentEvent    *Event          `json:"current_event,omitempty"`
+	EventLog        []EventLogEntry `json:"event_log,omitempty"`
+	EventsResponded int             `json:"events_responded,omitempty"` // Lifetime count, unlike the capped EventLog
 
 	// Circadian rhythm
-	Chronotype string `json:"chronotype,omitempty"`
+	Chronotype string    `json:"chronotype,omitempty"`
+	Schedule   *Schedule `json:"schedule,omitempty"` // Learned active window, nil until it first adapts
+
+	// Economy
+	PendingCoins []Transaction `json:"pending_coins,omitempty"` // Earnings not yet banked in the wallet
+	Toys         []string      `json:"toys,omitempty"`          // Owned toy shop item IDs
+	Cosmetic     string        `json:"cosmetic,omitempty"`      // Equipped cosmetic shop item ID
+
+	// Daily quests
+	Quests          *DailyQuests `json:"quests,omitempty"`
+	QuestStreak     int          `json:"quest_streak,omitempty"`
+	BestQuestStreak int          `json:"best_quest_streak,omitempty"`
+	LastQuestDay    string       `json:"last_quest_day,omitempty"` // Last day every quest was done
+
+	// Vacation mode
+	Vacation        *Vacation      `json:"vacation,omitempty"`
+	VacationHours   float64        `json:"vacation_hours,omitempty"` // Time away that didn't count toward age
+	LastVacationEnd *time.Time     `json:"last_vacation_end,omitempty"`
+	PastVacations   []VacationSpan `json:"past_vacations,omitempty"`   // Finished trips, so they never count as neglect
+	VacationSummary string         `json:"vacation_summary,omitempty"` // Report waiting to be shown on return
+
+	// Time zone the pet's day/night cycle follows, empty for the machine's local zone
+	TimeZone          string     `json:"time_zone,omitempty"`
+	TimeZoneChangedAt *time.Time `json:"time_zone_changed_at,omitempty"`
 
 	// Personality traits
-	Traits []Trait `json:"traits,omitempty"`
+	Traits        []Trait        `json:"traits,omitempty"`
+	TraitProgress map[string]int `json:"trait_progress,omitempty"` // trait name -> experiences toward it
+	TraitHistory  []TraitChange  `json:"trait_history,omitempty"`
 
 	// Bonding system
 	Bond             int           `json:"bond,omitempty"`
 	LastInteractions []Interaction `json:"last_interactions,omitempty"`
 
+	// Training system
+	Tricks []Trick `json:"tricks,omitempty"`
+
+	// Food pantry
+	Inventory   map[string]int `json:"inventory,omitempty"` // food type -> amount in stock
+	LastRestock time.Time      `json:"last_restock,omitempty"`
+
+	// Family tree, oldest ancestor first
+	Ancestry []Ancestor `json:"ancestry,omitempty"`
+
 	// Fractional stat accumulators
 	FractionalEnergy float64 `json:"fractional_energy,omitempty"`
+	WeightHours      float64 `json:"weight_hours,omitempty"` // Elapsed hours not yet worth a point of weight
 }
 
 // RecordStatCheckpoint records current stats for evolution tracking
@@ -116,15 +164,28 @@ func (p *Pet) RecordStatCheckpoint() {
 	}
 
 	stageKey := fmt.Sprintf("stage_%d", p.LifeStage)
-	checkpoint := StatCheck{
-		Time:      TimeNow(),
-		Hunger:    p.Hunger,
-		Happiness: p.Happiness,
-		Energy:    p.Energy,
-		Health:    p.Health,
+	p.StatCheckpoints[stageKey] = append(p.StatCheckpoints[stageKey], p.currentStats())
+}
+
+// currentStats snapshots the pet's stats at the current time
+func (p *Pet) currentStats() StatCheck {
+	return StatCheck{
+		Time:        TimeNow(),
+		Hunger:      p.Hunger,
+		Happiness:   p.Happiness,
+		Energy:      p.Energy,
+		Health:      p.Health,
+		Cleanliness: p.Cleanliness,
 	}
+}
 
-	p.StatCheckpoints[stageKey] = append(p.StatCheckpoints[stageKey], checkpoint)
+// RecordStatCheckpointIfDue records a checkpoint unless one was taken in this stage within the last hour
+func (p *Pet) RecordStatCheckpointIfDue() {
+	checkpoints := p.StatCheckpoints[fmt.Sprintf("stage_%d", p.LifeStage)]
+	if len(checkpoints) > 0 && TimeNow().Sub(checkpoints[len(checkpoints)-1].Time) < time.Hour {
+		return
+	}
+	p.RecordStatCheckpoint()
 }
 
 // CalculateCareQuality calculates average care quality for a life stage
@@ -134,135 +195,41 @@ func (p *Pet) CalculateCareQuality(stage int) CareQuality {
 
 	if len(checkpoints) == 0 {
 		return CareQuality{
-			AvgHunger:    MaxStat,
-			AvgHappiness: MaxStat,
-			AvgEnergy:    MaxStat,
-			AvgHealth:    MaxStat,
+			AvgHunger:      MaxStat,
+			AvgHappiness:   MaxStat,
+			AvgEnergy:      MaxStat,
+			AvgHealth:      MaxStat,
+			AvgCleanliness: MaxStat,
 		}
 	}
 
-	var totalHunger, totalHappiness, totalEnergy, totalHealth int
+	var totalHunger, totalHappiness, totalEnergy, totalHealth, totalCleanliness int
 	for _, checkpoint := range checkpoints {
 		totalHunger += checkpoint.Hunger
 		totalHappiness += checkpoint.Happiness
 		totalEnergy += checkpoint.Energy
 		totalHealth += checkpoint.Health
+		totalCleanliness += checkpoint.Cleanliness
 	}
 
 	count := len(checkpoints)
 	return CareQuality{
-		AvgHunger:    totalHunger / count,
-		AvgHappiness: totalHappiness / count,
-		AvgEnergy:    totalEnergy / count,
-		AvgHealth:    totalHealth / count,
+		AvgHunger:      totalHunger / count,
+		AvgHappiness:   totalHappiness / count,
+		AvgEnergy:      totalEnergy / count,
+		AvgHealth:      totalHealth / count,
+		AvgCleanliness: totalCleanliness / count,
 	}
 }
 
 // OverallAverage returns the average of all care quality stats
 func (cq CareQuality) OverallAverage() int {
-	return (cq.AvgHunger + cq.AvgHappiness + cq.AvgEnergy + cq.AvgHealth) / 4
-}
-
-// Evolve handles pet evolution when life stage changes
-func (p *Pet) Evolve(newStage int) {
-	prevStage := newStage - 1
-	careQuality := p.CalculateCareQuality(prevStage)
-
-	if p.CareQualityHistory == nil {
-		p.CareQualityHistory = make(map[int]CareQuality)
-	}
-	p.CareQualityHistory[prevStage] = careQuality
-
-	avgCare := careQuality.OverallAverage()
-
-	switch newStage {
-	case 1: // Evolving to Child
-		if avgCare >= GoodCareThreshold {
-			p.Form = FormHealthyChild
-		} else if avgCare >= PoorCareThreshold {
-			p.Form = FormTroubledChild
-		} else {
-			p.Form = FormSicklyChild
-		}
-
-	case 2: // Evolving to Adult
-		switch p.Form {
-		case FormHealthyChild:
-			if avgCare >= PerfectCareThreshold {
-				p.Form = FormEliteAdult
-			} else if avgCare >= GoodCareThreshold {
-				p.Form = FormStandardAdult
-			} else {
-				p.Form = FormGrumpyAdult
-			}
-		case FormTroubledChild:
-			if avgCare >= GoodCareThreshold {
-				p.Form = FormRedeemedAdult
-			} else {
-				p.Form = FormDelinquentAdult
-			}
-		case FormSicklyChild:
-			p.Form = FormWeakAdult
-		}
-	}
-
-	log.Printf("Pet evolved to %s (care quality: %d%%)", p.GetFormName(), avgCare)
-}
-
-// GetFormName returns the display name for the pet's current form
-func (p *Pet) GetFormName() string {
-	switch p.Form {
-	case FormBaby:
-		return "Baby"
-	case FormHealthyChild:
-		return "Healthy Child"
-	case FormTroubledChild:
-		return "Troubled Child"
-	case FormSicklyChild:
-		return "Sickly Child"
-	case FormEliteAdult:
-		return "Elite Adult"
-	case FormStandardAdult:
-		return "Standard Adult"
-	case FormGrumpyAdult:
-		return "Grumpy Adult"
-	case FormRedeemedAdult:
-		return "Redeemed Adult"
-	case FormDelinquentAdult:
-		return "Delinquent Adult"
-	case FormWeakAdult:
-		return "Weak Adult"
-	default:
-		return "Unknown"
-	}
+	return (cq.AvgHunger + cq.AvgHappiness + cq.AvgEnergy + cq.AvgHealth + cq.AvgCleanliness) / 5
 }
 
-// GetFormEmoji returns the emoji for the pet's current form
-func (p *Pet) GetFormEmoji() string {
-	switch p.Form {
-	case FormBaby:
-		return "🐣"
-	case FormHealthyChild:
-		return "😊"
-	case FormTroubledChild:
-		return "😟"
-	case FormSicklyChild:
-		return "🤒"
-	case FormEliteAdult:
-		return "⭐"
-	case FormStandardAdult:
-		return "😺"
-	case FormGrumpyAdult:
-		return "😼"
-	case FormRedeemedAdult:
-		return "😸"
-	case FormDelinquentAdult:
-		return "😾"
-	case FormWeakAdult:
-		return "🤕"
-	default:
-		return "❓"
-	}
+// Average returns the average of the stats recorded at a checkpoint
+func (c StatCheck) Average() int {
+	return (c.Hunger + c.Happiness + c.Energy + c.Health + c.Cleanliness) / 5
 }
 
 // GetTraitModifier returns the combined modifier for a given stat type
@@ -313,7 +280,7 @@ func CountRecentInteractions(interactions []Interaction, actionType string, wind
 // GetBondDescription returns a descriptive label for the bond level
 func GetBondDescription(bond int) string {
 	switch {
-	case bond >= 90:
+	case bond >= SoulmatesBond:
 		return "💕 Soulmates"
 	case bond >= 75:
 		return "❤️ Best Friends"
@@ -346,7 +313,7 @@ func GetChronotypeSchedule(chronotype string) (int, int) {
 
 // IsActiveHours checks if the given hour is within the pet's active window
 func IsActiveHours(p *Pet, hour int) bool {
-	wakeHour, sleepHour := GetChronotypeSchedule(p.Chronotype)
+	wakeHour, sleepHour := p.GetSchedule()
 
 	if sleepHour > wakeHour {
 		return hour >= wakeHour && hour < sleepHour
@@ -389,96 +356,6 @@ func AssignRandomChronotype() string {
 	return ChronotypeNightOwl
 }
 
-// GenerateTraits assigns random personality traits at birth
-func GenerateTraits() []Trait {
-	traitDefinitions := map[string][]Trait{
-		"temperament": {
-			{
-				Name:     "Calm",
-				Category: "temperament",
-				Modifiers: map[string]float64{
-					"energy_decay":    0.8,
-					"happiness_decay": 0.85,
-				},
-			},
-			{
-				Name:     "Hyperactive",
-				Category: "temperament",
-				Modifiers: map[string]float64{
-					"energy_decay": 1.3,
-					"play_bonus":   1.25,
-				},
-			},
-		},
-		"appetite": {
-			{
-				Name:     "Picky",
-				Category: "appetite",
-				Modifiers: map[string]float64{
-					"feed_bonus": 0.75,
-				},
-			},
-			{
-				Name:     "Hungry",
-				Category: "appetite",
-				Modifiers: map[string]float64{
-					"hunger_decay": 1.2,
-					"feed_bonus":   1.25,
-				},
-			},
-		},
-		"sociability": {
-			{
-				Name:     "Independent",
-				Category: "sociability",
-				Modifiers: map[string]float64{
-					"happiness_decay": 0.75,
-				},
-			},
-			{
-				Name:     "Needy",
-				Category: "sociability",
-				Modifiers: map[string]float64{
-					"happiness_decay":      1.15,
-					"play_bonus":           1.2,
-					"feed_bonus_happiness": 1.3,
-				},
-			},
-		},
-		"constitution": {
-			{
-				Name:     "Robust",
-				Category: "constitution",
-				Modifiers: map[string]float64{
-					"illness_chance": 0.5,
-					"health_decay":   0.85,
-				},
-			},
-			{
-				Name:     "Fragile",
-				Category: "constitution",
-				Modifiers: map[string]float64{
-					"illness_chance": 1.8,
-					"health_decay":   1.2,
-				},
-			},
-		},
-	}
-
-	var traits []Trait
-	for _, options := range traitDefinitions {
-		index := int(RandFloat64() * float64(len(options)))
-		if index >= len(options) {
-			index = len(options) - 1
-		}
-		selectedTrait := options[index]
-		traits = append(traits, selectedTrait)
-		log.Printf("Assigned %s trait: %s", selectedTrait.Category, selectedTrait.Name)
-	}
-
-	return traits
-}
-
 // GetWantEmoji returns an icon for the pet's most pressing desire when idle
 func GetWantEmoji(p Pet) string {
 	if p.Dead || p.Sleeping {
@@ -499,6 +376,7 @@ func GetWantEmoji(p Pet) string {
 		{deficit: MaxStat - p.Hunger, emoji: "🍖", threshold: WantHungerThreshold},
 		{deficit: MaxStat - p.Happiness, emoji: "🎾", threshold: WantHappyThreshold},
 		{deficit: MaxStat - p.Energy, emoji: "🛌", threshold: WantEnergyThreshold},
+		{deficit: MaxStat - p.Cleanliness, emoji: "🧽", threshold: WantCleanThreshold},
 	}
 
 	best := need{deficit: 0}
@@ -6,8 +6,10 @@ import (
 	"log"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
+	"unicode"
 )
 
 // testModel is a minimal model for testing pet interactions
@@ -159,8 +161,13 @@ func setupTestFile(t *testing.T) func() {
 
 // mockTimeNow sets a fixed time for deterministic tests and auto-restores after test
 func mockTimeNow(t *testing.T) time.Time {
+	return mockTimeNowIn(t, time.Local)
+}
+
+// mockTimeNowIn fixes the clock at Monday 2024-01-01 12:00 in the given location, for tests that check wall-clock hours
+func mockTimeNowIn(t *testing.T, loc *time.Location) time.Time {
 	originalTimeNow := TimeNow
-	currentTime := time.Date(2024, 1, 1, 12, 0, 0, 0, time.Local)
+	currentTime := time.Date(2024, 1, 1, 12, 0, 0, 0, loc)
 	TimeNow = func() time.Time { return currentTime }
 	t.Cleanup(func() { TimeNow = originalTimeNow })
 	return currentTime
@@ -738,6 +745,13 @@ func TestIllnessSystem(t *testing.T) {
 	currentTime := mockTimeNow(t)
 
 	t.Run("Develop illness", func(t *testing.T) {
+		// Put the clock and dice back so later subtests read the same time as their fixtures
+		originalRandFloat64 := RandFloat64
+		t.Cleanup(func() {
+			RandFloat64 = originalRandFloat64
+			TimeNow = func() time.Time { return currentTime }
+		})
+
 		// Force deterministic illness check
 		RandFloat64 = func() float64 { return 0.05 } // Always < 0.1 illness threshold
 
@@ -799,7 +813,9 @@ func TestIllnessSystem(t *testing.T) {
 	})
 
 	t.Run("Auto-heal from illness", func(t *testing.T) {
-		// Create sick pet that will recover
+		currentTime := TimeNow() // The clock LoadState reads
+
+		// Create sick pet whose illness has run its course
 		testCfg := &TestConfig{
 			Health:        40,
 			Illness:       true,
@@ -807,11 +823,17 @@ func TestIllnessSystem(t *testing.T) {
 		}
 		pet := NewPet(testCfg)
 		pet.Health = 60 // Set health to safe level
+		pet.Disease = &Disease{
+			Type:       DiseaseCold,
+			CaughtAt:   currentTime.Add(-30 * time.Hour),
+			SymptomsAt: currentTime.Add(-28 * time.Hour),
+			RecoversAt: currentTime.Add(-30 * time.Minute),
+		}
 		SaveState(&pet)
 
 		loadedPet := LoadState()
-		if loadedPet.Illness {
-			t.Error("Pet should automatically recover from illness when health >= 50")
+		if loadedPet.Illness || loadedPet.Disease != nil {
+			t.Error("Pet should automatically recover once the illness runs its course")
 		}
 	})
 }
@@ -1231,12 +1253,16 @@ func TestAging(t *testing.T) {
 			expected  int
 			stageName string
 		}{
-			{0, 0, "Baby"},
-			{47, 0, "Baby"},
-			{48, 1, "Child"},
-			{95, 1, "Child"},
-			{96, 2, "Adult"},
-			{200, 2, "Adult"}, // Should stay adult
+			{0, StageBaby, "Baby"},
+			{47, StageBaby, "Baby"},
+			{48, StageChild, "Child"},
+			{71, StageChild, "Child"},
+			{72, StageTeen, "Teen"},
+			{95, StageTeen, "Teen"},
+			{96, StageAdult, "Adult"},
+			{143, StageAdult, "Adult"},
+			{144, StageSenior, "Senior"},
+			{200, StageSenior, "Senior"}, // Should stay senior
 		}
 
 		for _, tc := range testCases {
@@ -1466,6 +1492,7 @@ func TestStatCalculationPrecision(t *testing.T) {
 			LastSavedTime:    twoHoursAgo,
 		}
 		pet := NewPet(testCfg)
+		pet.Traits = []Trait{} // Clear traits for predictable results
 		SaveState(&pet)
 
 		// Fix LastSaved time in file
@@ -1729,7 +1756,7 @@ func TestLifeEvents(t *testing.T) {
 		pet.Mood = "playful"
 		pet.CurrentEvent = nil
 
-		TriggerRandomEvent(&pet)
+		TriggerRandomEvent(&pet, QuietSchedule{})
 
 		if pet.CurrentEvent == nil {
 			t.Error("Expected event to trigger")
@@ -1751,7 +1778,7 @@ func TestLifeEvents(t *testing.T) {
 		}
 		pet.CurrentEvent = existingEvent
 
-		TriggerRandomEvent(&pet)
+		TriggerRandomEvent(&pet, QuietSchedule{})
 
 		// Should still be the same event
 		if pet.CurrentEvent.Type != EventChasing {
@@ -1776,7 +1803,7 @@ func TestLifeEvents(t *testing.T) {
 		}
 		pet.CurrentEvent = expiredEvent
 
-		TriggerRandomEvent(&pet)
+		TriggerRandomEvent(&pet, QuietSchedule{})
 
 		// Scared event penalty: -15 happiness
 		if pet.Happiness != 35 {
@@ -1886,7 +1913,7 @@ func TestLifeEvents(t *testing.T) {
 		pet.Dead = true
 		pet.CurrentEvent = nil
 
-		TriggerRandomEvent(&pet)
+		TriggerRandomEvent(&pet, QuietSchedule{})
 
 		if pet.CurrentEvent != nil {
 			t.Error("Dead pet should not get events")
@@ -2356,6 +2383,34 @@ func TestBondingSystem(t *testing.T) {
 		}
 	})
 
+	t.Run("Refused medicine doesn't hold off neglect", func(t *testing.T) {
+		originalRandFloat64 := RandFloat64
+		RandFloat64 = func() float64 { return 1.0 } // Prevent illness
+		defer func() { RandFloat64 = originalRandFloat64 }()
+
+		thirtySevenHoursAgo := currentTime.Add(-37 * time.Hour)
+		pet := NewPet(&TestConfig{InitialHunger: 100, InitialHappiness: 100, InitialEnergy: 100, Health: 100,
+			LastSavedTime: thirtySevenHoursAgo})
+		pet.Bond = 80
+		pet.LastInteractions = []Interaction{{Type: "feed", Time: thirtySevenHoursAgo}}
+		if _, ok := pet.GiveMedicine(); ok {
+			t.Fatal("Expected a healthy pet to refuse medicine")
+		}
+		pet.Checkup()
+		SaveState(&pet)
+
+		data, _ := os.ReadFile(TestConfigPath)
+		var savedPet Pet
+		json.Unmarshal(data, &savedPet)
+		savedPet.LastSaved = thirtySevenHoursAgo
+		data, _ = json.MarshalIndent(savedPet, "", "  ")
+		os.WriteFile(TestConfigPath, data, 0644)
+
+		if loadedPet := LoadState(); loadedPet.Bond != 79 {
+			t.Errorf("Expected vet visits not to reset the neglect timer, got bond %d", loadedPet.Bond)
+		}
+	})
+
 	t.Run("Bond does not decay below 0", func(t *testing.T) {
 		// Create pet with low bond and long neglect
 		fiftyHoursAgo := currentTime.Add(-50 * time.Hour)
@@ -2618,11 +2673,12 @@ func TestEvolution(t *testing.T) {
 		// Manually add checkpoints to simulate poor care during baby stage
 		for i := 0; i < 48; i++ { // 48 hours of baby stage
 			pet.StatCheckpoints["stage_0"] = append(pet.StatCheckpoints["stage_0"], StatCheck{
-				Time:      birthTime.Add(time.Duration(i) * time.Hour),
-				Hunger:    50,
-				Happiness: 50,
-				Energy:    50,
-				Health:    50,
+				Time:        birthTime.Add(time.Duration(i) * time.Hour),
+				Hunger:      50,
+				Happiness:   50,
+				Energy:      50,
+				Health:      50,
+				Cleanliness: 50,
 			})
 		}
 
@@ -2696,11 +2752,12 @@ func TestEvolution(t *testing.T) {
 		// Manually add checkpoints to simulate neglect during baby stage
 		for i := 0; i < 48; i++ {
 			pet.StatCheckpoints["stage_0"] = append(pet.StatCheckpoints["stage_0"], StatCheck{
-				Time:      birthTime.Add(time.Duration(i) * time.Hour),
-				Hunger:    15,
-				Happiness: 15,
-				Energy:    15,
-				Health:    15,
+				Time:        birthTime.Add(time.Duration(i) * time.Hour),
+				Hunger:      15,
+				Happiness:   15,
+				Energy:      15,
+				Health:      15,
+				Cleanliness: 15,
 			})
 		}
 
@@ -2740,11 +2797,12 @@ func TestEvolution(t *testing.T) {
 		// Manually add checkpoints to simulate good care during child stage
 		for i := 0; i < 48; i++ {
 			pet.StatCheckpoints["stage_1"] = append(pet.StatCheckpoints["stage_1"], StatCheck{
-				Time:      birthTime.Add(time.Duration(i) * time.Hour),
-				Hunger:    75,
-				Happiness: 75,
-				Energy:    75,
-				Health:    75,
+				Time:        birthTime.Add(time.Duration(i) * time.Hour),
+				Hunger:      75,
+				Happiness:   75,
+				Energy:      75,
+				Health:      75,
+				Cleanliness: 75,
 			})
 		}
 
@@ -2784,11 +2842,12 @@ func TestEvolution(t *testing.T) {
 		// Manually add checkpoints to simulate poor care during child stage
 		for i := 0; i < 48; i++ {
 			pet.StatCheckpoints["stage_1"] = append(pet.StatCheckpoints["stage_1"], StatCheck{
-				Time:      birthTime.Add(time.Duration(i) * time.Hour),
-				Hunger:    45,
-				Happiness: 45,
-				Energy:    45,
-				Health:    45,
+				Time:        birthTime.Add(time.Duration(i) * time.Hour),
+				Hunger:      45,
+				Happiness:   45,
+				Energy:      45,
+				Health:      45,
+				Cleanliness: 45,
 			})
 		}
 
@@ -2860,11 +2919,12 @@ func TestEvolution(t *testing.T) {
 		// Manually add checkpoints to simulate continued neglect during child stage
 		for i := 0; i < 48; i++ {
 			pet.StatCheckpoints["stage_1"] = append(pet.StatCheckpoints["stage_1"], StatCheck{
-				Time:      birthTime.Add(time.Duration(i) * time.Hour),
-				Hunger:    30,
-				Happiness: 30,
-				Energy:    30,
-				Health:    30,
+				Time:        birthTime.Add(time.Duration(i) * time.Hour),
+				Hunger:      30,
+				Happiness:   30,
+				Energy:      30,
+				Health:      30,
+				Cleanliness: 30,
 			})
 		}
 
@@ -2931,9 +2991,9 @@ func TestTraitSystem(t *testing.T) {
 
 		traits := GenerateTraits()
 
-		// Should have 4 categories: temperament, appetite, sociability, constitution
-		if len(traits) != 4 {
-			t.Fatalf("Expected 4 traits, got %d", len(traits))
+		// Should have one trait per category
+		if len(traits) != len(GetTraitCategories()) {
+			t.Fatalf("Expected %d traits, got %d", len(GetTraitCategories()), len(traits))
 		}
 
 		// Check all categories are present
@@ -2942,7 +3002,8 @@ func TestTraitSystem(t *testing.T) {
 			categories[trait.Category] = true
 		}
 
-		expectedCategories := []string{"temperament", "appetite", "sociability", "constitution"}
+		expectedCategories := []string{"temperament", "appetite", "sociability", "constitution",
+			"curiosity", "courage", "vocal", "sleepiness"}
 		for _, cat := range expectedCategories {
 			if !categories[cat] {
 				t.Errorf("Missing category: %s", cat)
@@ -2955,12 +3016,16 @@ func TestTraitSystem(t *testing.T) {
 
 		traits := GenerateTraits()
 
-		// First options: Calm, Picky, Independent, Robust
+		// First options: Calm, Picky, Independent, Robust, Curious, Brave, Chatty, Sleepyhead
 		expectedTraits := map[string]string{
 			"temperament":  "Calm",
 			"appetite":     "Picky",
 			"sociability":  "Independent",
 			"constitution": "Robust",
+			"curiosity":    "Curious",
+			"courage":      "Brave",
+			"vocal":        "Chatty",
+			"sleepiness":   "Sleepyhead",
 		}
 
 		for _, trait := range traits {
@@ -2972,16 +3037,20 @@ func TestTraitSystem(t *testing.T) {
 	})
 
 	t.Run("GenerateTraits selects second option with high roll", func(t *testing.T) {
-		RandFloat64 = func() float64 { return 0.9 } // Always select last option
+		RandFloat64 = func() float64 { return 0.9 } // Second common option, below the rare slice
 
 		traits := GenerateTraits()
 
-		// Second options: Hyperactive, Hungry, Needy, Fragile
+		// Second options: Hyperactive, Hungry, Needy, Fragile, Cautious, Timid, Quiet, Restless
 		expectedTraits := map[string]string{
 			"temperament":  "Hyperactive",
 			"appetite":     "Hungry",
 			"sociability":  "Needy",
 			"constitution": "Fragile",
+			"curiosity":    "Cautious",
+			"courage":      "Timid",
+			"vocal":        "Quiet",
+			"sleepiness":   "Restless",
 		}
 
 		for _, trait := range traits {
@@ -3691,3 +3760,3169 @@ func TestNegativeAndErrorCases(t *testing.T) {
 		}
 	})
 }
+
+func TestTrickSystem(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+
+	mockTimeNow(t)
+
+	originalRandFloat64 := RandFloat64
+	defer func() { RandFloat64 = originalRandFloat64 }()
+
+	newTrainee := func() Pet {
+		RandFloat64 = func() float64 { return 0.0 }
+		p := NewPet(&TestConfig{
+			InitialHunger:    80,
+			InitialHappiness: 80,
+			InitialEnergy:    80,
+			Health:           100,
+		})
+		p.Mood = "normal"
+		p.Bond = MaxBond
+		return p
+	}
+
+	t.Run("Training refuses when sleeping or tired", func(t *testing.T) {
+		p := newTrainee()
+		p.Sleeping = true
+		if _, ok := p.Train(); ok {
+			t.Error("Expected sleeping pet to refuse training")
+		}
+
+		p.Sleeping = false
+		p.Energy = TrainMinEnergy - 1
+		if _, ok := p.Train(); ok {
+			t.Error("Expected tired pet to refuse training")
+		}
+		if len(p.Tricks) != 0 {
+			t.Errorf("Expected no tricks after refused training, got %d", len(p.Tricks))
+		}
+	})
+
+	t.Run("First session starts learning and announces the trick", func(t *testing.T) {
+		p := newTrainee()
+		energyBefore := p.Energy
+
+		msg, ok := p.Train()
+		if !ok {
+			t.Fatalf("Expected training to happen, got message %q", msg)
+		}
+		if len(p.Tricks) != 1 || p.Tricks[0].Name != "Sit" {
+			t.Fatalf("Expected to start learning Sit, got %+v", p.Tricks)
+		}
+		if p.Tricks[0].Proficiency != TrainProgressGain {
+			t.Errorf("Expected proficiency %d, got %d", TrainProgressGain, p.Tricks[0].Proficiency)
+		}
+		if p.Energy != energyBefore-TrainEnergyCost {
+			t.Errorf("Expected energy %d, got %d", energyBefore-TrainEnergyCost, p.Energy)
+		}
+		if p.CurrentEvent == nil || p.CurrentEvent.Type != EventLearnedTrick {
+			t.Error("Expected learned-trick event after starting a new trick")
+		}
+	})
+
+	t.Run("Failed roll spends energy without progress", func(t *testing.T) {
+		p := newTrainee()
+		RandFloat64 = func() float64 { return 0.99 }
+
+		_, ok := p.Train()
+		if !ok {
+			t.Fatal("Expected failed session to still count as training")
+		}
+		if len(p.Tricks) != 0 {
+			t.Errorf("Expected no progress on failed session, got %+v", p.Tricks)
+		}
+		if p.Energy != 80-TrainEnergyCost {
+			t.Errorf("Expected energy to be spent, got %d", p.Energy)
+		}
+	})
+
+	t.Run("Low bond reduces success chance", func(t *testing.T) {
+		p := newTrainee()
+		p.Bond = 0
+		// 0.8 * 0.5 = 0.4 success chance at zero bond
+		RandFloat64 = func() float64 { return 0.5 }
+		p.Train()
+		if len(p.Tricks) != 0 {
+			t.Error("Expected training to fail at low bond with a 0.5 roll")
+		}
+
+		p.Bond = MaxBond
+		p.LastInteractions = nil
+		p.Train()
+		if len(p.Tricks) != 1 {
+			t.Error("Expected training to succeed at full bond with a 0.5 roll")
+		}
+	})
+
+	t.Run("Advanced tricks require bond", func(t *testing.T) {
+		p := newTrainee()
+		p.Bond = 20
+		p.Tricks = []Trick{{Name: "Sit", Proficiency: MaxStat}}
+
+		msg, ok := p.Train()
+		if ok {
+			t.Errorf("Expected no training when next trick needs more bond, got %q", msg)
+		}
+	})
+
+	t.Run("Repeated sessions have diminishing returns", func(t *testing.T) {
+		p := newTrainee()
+		p.Train()
+		first := p.Tricks[0].Proficiency
+		p.Energy = 80
+		p.Train()
+		second := p.Tricks[0].Proficiency - first
+
+		if second >= first {
+			t.Errorf("Expected spam training to give less progress: first %d, second %d", first, second)
+		}
+	})
+
+	t.Run("Reaching mastery announces the trick", func(t *testing.T) {
+		p := newTrainee()
+		p.Tricks = []Trick{{Name: "Sit", Proficiency: TrickMasteredThreshold - 1}}
+
+		msg, ok := p.Train()
+		if !ok {
+			t.Fatalf("Expected training to happen, got %q", msg)
+		}
+		if p.Tricks[0].Proficiency < TrickMasteredThreshold {
+			t.Errorf("Expected Sit to be mastered, got %d", p.Tricks[0].Proficiency)
+		}
+		if p.CurrentEvent == nil || p.CurrentEvent.Type != EventLearnedTrick {
+			t.Error("Expected learned-trick event on mastery")
+		}
+		if p.CountMasteredTricks() != 1 {
+			t.Errorf("Expected 1 mastered trick, got %d", p.CountMasteredTricks())
+		}
+	})
+
+	t.Run("Mastered tricks move training to the next trick", func(t *testing.T) {
+		p := newTrainee()
+		p.Tricks = []Trick{{Name: "Sit", Proficiency: MaxStat}}
+
+		p.Train()
+		if len(p.Tricks) != 2 || p.Tricks[1].Name != "Paw" {
+			t.Errorf("Expected to start learning Paw, got %+v", p.Tricks)
+		}
+	})
+
+	t.Run("Performing tricks gives happiness scaled by proficiency", func(t *testing.T) {
+		p := newTrainee()
+		if _, ok := p.PerformTrick(); ok {
+			t.Error("Expected pet with no tricks to refuse performing")
+		}
+
+		p.Tricks = []Trick{{Name: "Sit", Proficiency: MaxStat}}
+		p.Happiness = 50
+		if _, ok := p.PerformTrick(); !ok {
+			t.Fatal("Expected pet to perform a known trick")
+		}
+		masteredGain := p.Happiness - 50
+
+		shaky := newTrainee()
+		shaky.Tricks = []Trick{{Name: "Sit", Proficiency: 10}}
+		shaky.Happiness = 50
+		shaky.PerformTrick()
+		shakyGain := shaky.Happiness - 50
+
+		if masteredGain != PerformHappinessIncrease {
+			t.Errorf("Expected mastered trick to give %d happiness, got %d", PerformHappinessIncrease, masteredGain)
+		}
+		if shakyGain >= masteredGain {
+			t.Errorf("Expected shaky trick to give less happiness: shaky %d, mastered %d", shakyGain, masteredGain)
+		}
+	})
+
+	t.Run("Responding to learned-trick event practices the trick it taught", func(t *testing.T) {
+		p := newTrainee()
+		p.Tricks = []Trick{{Name: "Sit", Proficiency: 90}, {Name: "Paw", Proficiency: 50}}
+		p.Happiness = 60
+		p.announceTrick("Paw")
+
+		msg := p.RespondToEvent()
+		if !strings.Contains(msg, "Paw") {
+			t.Fatalf("Expected response message about Paw, got %q", msg)
+		}
+		if p.Tricks[1].Proficiency != 50+TrickPracticeGain || p.Tricks[0].Proficiency != 90 {
+			t.Errorf("Expected only Paw to improve, got %+v", p.Tricks)
+		}
+		if p.Happiness != 70 {
+			t.Errorf("Expected happiness 70, got %d", p.Happiness)
+		}
+	})
+
+	t.Run("An unanswered learned-trick event isn't held against the owner", func(t *testing.T) {
+		p := newTrainee()
+		p.Tricks = []Trick{{Name: "Sit", Proficiency: 50}}
+		p.Happiness = 60
+		p.announceTrick("Sit")
+		p.CurrentEvent.ExpiresAt = TimeNow().Add(-time.Minute)
+
+		RandFloat64 = func() float64 { return 0.99 }
+		TriggerRandomEvent(&p, QuietSchedule{})
+		if p.CurrentEvent != nil {
+			t.Errorf("Expected the announcement to run out, got %+v", p.CurrentEvent)
+		}
+		if p.Happiness != 60 || len(p.EventLog) != 0 {
+			t.Errorf("Expected no penalty or ignored log entry, got happiness %d and log %+v", p.Happiness, p.EventLog)
+		}
+	})
+
+	t.Run("Tricks persist across save and load", func(t *testing.T) {
+		p := newTrainee()
+		p.Tricks = []Trick{{Name: "Spin", Proficiency: 45}}
+		SaveState(&p)
+
+		RandFloat64 = func() float64 { return 0.99 }
+		loaded := LoadState()
+		if len(loaded.Tricks) != 1 || loaded.Tricks[0].Name != "Spin" || loaded.Tricks[0].Proficiency != 45 {
+			t.Errorf("Expected Spin at 45%% after reload, got %+v", loaded.Tricks)
+		}
+		if GetTricksDisplay(loaded) != "Spin 45%" {
+			t.Errorf("Unexpected tricks display: %q", GetTricksDisplay(loaded))
+		}
+	})
+}
+
+func TestEvolutionTree(t *testing.T) {
+	goodCare := CareQuality{AvgHunger: 80, AvgHappiness: 80, AvgEnergy: 80, AvgHealth: 80, AvgCleanliness: 80}
+	perfectCare := CareQuality{AvgHunger: 95, AvgHappiness: 95, AvgEnergy: 95, AvgHealth: 95, AvgCleanliness: 95}
+	poorCare := CareQuality{AvgHunger: 30, AvgHappiness: 30, AvgEnergy: 30, AvgHealth: 30, AvgCleanliness: 30}
+
+	t.Run("Every branch targets a defined form one stage later", func(t *testing.T) {
+		for _, branch := range GetEvolutionTree() {
+			from := GetFormDefinition(branch.From)
+			to := GetFormDefinition(branch.To)
+			if from == nil || to == nil {
+				t.Fatalf("Branch %d -> %d references an undefined form", branch.From, branch.To)
+			}
+			if lifeStageIndex(to.Stage) != lifeStageIndex(from.Stage)+1 {
+				t.Errorf("Branch %s -> %s skips or reverses stages", from.Name, to.Name)
+			}
+		}
+	})
+
+	t.Run("Every non-senior form has a fallback branch", func(t *testing.T) {
+		for _, def := range GetFormDefinitions() {
+			if def.Stage == StageSenior {
+				continue
+			}
+			branches := GetEvolutionBranches(def.Form)
+			if len(branches) == 0 || branches[len(branches)-1].Requirement != nil {
+				t.Errorf("Form %s needs an unconditional last branch", def.Name)
+			}
+		}
+	})
+
+	t.Run("Original ten forms remain reachable", func(t *testing.T) {
+		reachable := map[PetForm]bool{FormBaby: true}
+		for _, branch := range GetEvolutionTree() {
+			reachable[branch.To] = true
+		}
+		original := []PetForm{FormHealthyChild, FormTroubledChild, FormSicklyChild, FormEliteAdult,
+			FormStandardAdult, FormGrumpyAdult, FormRedeemedAdult, FormDelinquentAdult, FormWeakAdult}
+		for _, form := range original {
+			if !reachable[form] {
+				p := Pet{Form: form}
+				t.Errorf("Form %s is no longer reachable", p.GetFormName())
+			}
+		}
+	})
+
+	tests := []struct {
+		name     string
+		from     PetForm
+		ctx      EvolutionContext
+		expected PetForm
+	}{
+		{"Healthy child becomes healthy teen", FormHealthyChild, EvolutionContext{Care: goodCare}, FormHealthyTeen},
+		{"Trick-savvy child becomes prodigy", FormHealthyChild, EvolutionContext{Care: goodCare, CompetentTricks: 2}, FormProdigyTeen},
+		{"Ignored child rebels", FormHealthyChild, EvolutionContext{Care: goodCare, EventCount: 10, ResponseRate: 10}, FormRebelTeen},
+		{"Few events don't count as ignored", FormHealthyChild, EvolutionContext{Care: goodCare, EventCount: 2, ResponseRate: 0}, FormHealthyTeen},
+		{"Bonded teen becomes devoted", FormHealthyTeen, EvolutionContext{Care: goodCare, Bond: 95}, FormDevotedAdult},
+		{"Perfect care teen becomes elite", FormHealthyTeen, EvolutionContext{Care: perfectCare, Bond: 50}, FormEliteAdult},
+		{"Prodigy with mastered tricks performs", FormProdigyTeen, EvolutionContext{Care: poorCare, MasteredTricks: 3}, FormPerformerAdult},
+		{"Independent rebel becomes free spirit", FormRebelTeen, EvolutionContext{Care: goodCare, Traits: map[string]bool{"Independent": true}}, FormFreeSpiritAdult},
+		{"Neglected rebel becomes delinquent", FormRebelTeen, EvolutionContext{Care: poorCare}, FormDelinquentAdult},
+		{"Sickly teen with attentive owner survives", FormSicklyTeen, EvolutionContext{Care: goodCare, EventCount: 10, ResponseRate: 90}, FormSurvivorAdult},
+		{"Secret phoenix form", FormSicklyTeen, EvolutionContext{Care: perfectCare, Bond: 85, EventCount: 10, ResponseRate: 90}, FormPhoenixAdult},
+		{"Sickly teen without attention is weak", FormSicklyTeen, EvolutionContext{Care: perfectCare}, FormWeakAdult},
+		{"Calm adult becomes serene elder", FormStandardAdult, EvolutionContext{Care: goodCare, Traits: map[string]bool{"Calm": true}}, FormSereneElder},
+		{"Trick master becomes wise elder", FormEliteAdult, EvolutionContext{Care: goodCare, MasteredTricks: 3}, FormWiseElder},
+		{"Grumpy adult stays cranky", FormGrumpyAdult, EvolutionContext{Care: goodCare}, FormCrankyElder},
+		{"Weak adult becomes frail", FormWeakAdult, EvolutionContext{Care: goodCare}, FormFrailElder},
+		{"Cared-for adult becomes golden elder", FormStandardAdult, EvolutionContext{Care: goodCare}, FormGoldenElder},
+		{"Secret legend form", FormPerformerAdult, EvolutionContext{Care: poorCare, Bond: 100, MasteredTricks: len(GetTrickDefinitions())}, FormLegendElder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.ctx.Traits == nil {
+				tt.ctx.Traits = map[string]bool{}
+			}
+			got, ok := SelectEvolution(tt.from, tt.ctx)
+			if !ok {
+				t.Fatal("Expected an evolution branch to match")
+			}
+			if got != tt.expected {
+				gotPet, wantPet := Pet{Form: got}, Pet{Form: tt.expected}
+				t.Errorf("Expected %s, got %s", wantPet.GetFormName(), gotPet.GetFormName())
+			}
+		})
+	}
+
+	t.Run("Senior forms don't evolve further", func(t *testing.T) {
+		if _, ok := SelectEvolution(FormGoldenElder, EvolutionContext{Care: goodCare}); ok {
+			t.Error("Expected no branch from a senior form")
+		}
+	})
+
+	t.Run("Evolution context reads bond, traits, events and tricks", func(t *testing.T) {
+		p := Pet{
+			Bond:   77,
+			Traits: []Trait{{Name: "Calm"}},
+			EventLog: []EventLogEntry{
+				{Type: EventScared, WasIgnored: true},
+				{Type: EventCuddles},
+				{Type: EventChasing},
+				{Type: EventZoomies},
+			},
+			Tricks: []Trick{{Name: "Sit", Proficiency: 90}, {Name: "Paw", Proficiency: 50}, {Name: "Spin", Proficiency: 10}},
+		}
+		ctx := p.NewEvolutionContext(goodCare)
+
+		if ctx.Bond != 77 || !ctx.Traits["Calm"] {
+			t.Errorf("Unexpected bond/traits in context: %+v", ctx)
+		}
+		if ctx.EventCount != 4 || ctx.ResponseRate != 75 {
+			t.Errorf("Expected 4 events at 75%% response, got %d at %d%%", ctx.EventCount, ctx.ResponseRate)
+		}
+		if ctx.CompetentTricks != 2 || ctx.MasteredTricks != 1 {
+			t.Errorf("Expected 2 competent and 1 mastered trick, got %d and %d", ctx.CompetentTricks, ctx.MasteredTricks)
+		}
+	})
+
+	t.Run("Skipped stages inherit the last recorded care", func(t *testing.T) {
+		p := Pet{StatCheckpoints: map[string][]StatCheck{
+			"stage_1": {{Hunger: 40, Happiness: 40, Energy: 40, Health: 40, Cleanliness: 40}},
+		}}
+		if got := p.StageCareQuality(StageTeen).OverallAverage(); got != 40 {
+			t.Errorf("Expected teen stage to inherit child care of 40, got %d", got)
+		}
+		if got := p.StageCareQuality(StageBaby).OverallAverage(); got != MaxStat {
+			t.Errorf("Expected unrecorded baby stage to default to %d, got %d", MaxStat, got)
+		}
+	})
+
+	t.Run("Evolve walks through each stage in order", func(t *testing.T) {
+		p := Pet{Form: FormBaby, Bond: 50}
+		for _, stage := range lifeStagesBetween(StageBaby, StageSenior) {
+			p.Evolve(stage)
+			def := GetFormDefinition(p.Form)
+			if def == nil || def.Stage != stage {
+				t.Fatalf("Expected a %s form after evolving, got %s", GetLifeStageName(stage), p.GetFormName())
+			}
+		}
+		if p.Form != FormGoldenElder {
+			t.Errorf("Expected unrecorded care to end at Golden Elder, got %s", p.GetFormName())
+		}
+	})
+}
+
+func TestEvolutionForecast(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newChild := func() Pet {
+		return Pet{
+			Form:      FormHealthyChild,
+			LifeStage: StageChild,
+			Bond:      50,
+			Logs:      []LogEntry{{Time: now.Add(-60 * time.Hour)}},
+			StatCheckpoints: map[string][]StatCheck{
+				"stage_1": {
+					{Hunger: 90, Happiness: 80, Energy: 50, Health: 100, Cleanliness: 100},
+					{Hunger: 70, Happiness: 80, Energy: 30, Health: 100, Cleanliness: 100},
+				},
+			},
+		}
+	}
+
+	t.Run("Reports running care averages and the weakest stat", func(t *testing.T) {
+		forecast := ForecastEvolution(newChild(), now)
+
+		if forecast.Care.AvgHunger != 80 || forecast.Care.AvgEnergy != 40 {
+			t.Errorf("Unexpected running averages: %+v", forecast.Care)
+		}
+		if forecast.Checkpoints != 2 {
+			t.Errorf("Expected 2 checkpoints, got %d", forecast.Checkpoints)
+		}
+		if forecast.WeakestStat.Name != "Energy" || forecast.WeakestStat.Value != 40 {
+			t.Errorf("Expected Energy at 40 to be weakest, got %+v", forecast.WeakestStat)
+		}
+	})
+
+	t.Run("Projects the next form and time until evolution", func(t *testing.T) {
+		forecast := ForecastEvolution(newChild(), now)
+
+		if !forecast.HasNextStage || forecast.NextStage != StageTeen {
+			t.Fatalf("Expected next stage Teen, got %d (has next: %v)", forecast.NextStage, forecast.HasNextStage)
+		}
+		if forecast.ProjectedForm != FormHealthyTeen {
+			p := Pet{Form: forecast.ProjectedForm}
+			t.Errorf("Expected Healthy Teen, got %s", p.GetFormName())
+		}
+		if forecast.TimeUntil != 12*time.Hour {
+			t.Errorf("Expected 12h until evolution, got %v", forecast.TimeUntil)
+		}
+		if forecast.TimeUntilDisplay() != "12h 0m" {
+			t.Errorf("Unexpected time display: %q", forecast.TimeUntilDisplay())
+		}
+	})
+
+	t.Run("Projection reacts to tricks and bond", func(t *testing.T) {
+		p := newChild()
+		p.StatCheckpoints["stage_1"] = []StatCheck{{Hunger: 90, Happiness: 90, Energy: 90, Health: 90, Cleanliness: 90}}
+		p.Tricks = []Trick{{Name: "Sit", Proficiency: 50}, {Name: "Paw", Proficiency: 50}}
+
+		if forecast := ForecastEvolution(p, now); forecast.ProjectedForm != FormProdigyTeen {
+			t.Errorf("Expected Prodigy Teen projection with two competent tricks")
+		}
+	})
+
+	t.Run("Secret forms stay hidden", func(t *testing.T) {
+		p := Pet{
+			Form:      FormSicklyTeen,
+			LifeStage: StageTeen,
+			Bond:      90,
+			Logs:      []LogEntry{{Time: now.Add(-80 * time.Hour)}},
+		}
+		for i := 0; i < 10; i++ {
+			p.EventLog = append(p.EventLog, EventLogEntry{Type: EventCuddles})
+		}
+
+		forecast := ForecastEvolution(p, now)
+		if forecast.ProjectedForm != FormPhoenixAdult || !forecast.Secret {
+			t.Fatalf("Expected secret Phoenix projection, got %+v", forecast)
+		}
+		if forecast.ProjectedFormDisplay() != "❓ ???" {
+			t.Errorf("Expected secret form to be hidden, got %q", forecast.ProjectedFormDisplay())
+		}
+	})
+
+	t.Run("Seniors have no next stage", func(t *testing.T) {
+		p := Pet{Form: FormGoldenElder, LifeStage: StageSenior, Logs: []LogEntry{{Time: now.Add(-150 * time.Hour)}}}
+		forecast := ForecastEvolution(p, now)
+		if forecast.HasNextStage {
+			t.Error("Expected no next stage for a senior")
+		}
+		if forecast.ProjectedFormDisplay() != "Final form" {
+			t.Errorf("Unexpected display for final form: %q", forecast.ProjectedFormDisplay())
+		}
+	})
+
+	t.Run("LoadState records at most one checkpoint per hour", func(t *testing.T) {
+		cleanup := setupTestFile(t)
+		defer cleanup()
+		mockTimeNow(t)
+
+		p := NewPet(&TestConfig{InitialHunger: 80, InitialHappiness: 80, InitialEnergy: 80, Health: 80})
+		SaveState(&p)
+
+		LoadState()
+		loaded := LoadState()
+		SaveState(&loaded)
+		loaded = LoadState()
+
+		if got := len(loaded.StatCheckpoints["stage_0"]); got != 1 {
+			t.Errorf("Expected 1 checkpoint within the hour, got %d", got)
+		}
+	})
+}
+
+func TestEvolutionHistory(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	newBaby := func(age time.Duration) Pet {
+		return Pet{
+			Name:      "Test",
+			Form:      FormBaby,
+			LifeStage: StageBaby,
+			Hunger:    MaxStat,
+			Happiness: MaxStat,
+			Energy:    MaxStat,
+			Health:    MaxStat,
+			Bond:      InitialBond,
+			Logs:      []LogEntry{{Time: now.Add(-age)}},
+		}
+	}
+
+	t.Run("No record before the first stage change", func(t *testing.T) {
+		p := newBaby(10 * time.Hour)
+		if records := p.UpdateLifeStage(now); len(records) != 0 {
+			t.Errorf("Expected no evolutions, got %d", len(records))
+		}
+		if len(p.PendingEvolutions()) != 0 {
+			t.Error("Expected nothing pending for a baby")
+		}
+	})
+
+	t.Run("Records one entry per stage crossed", func(t *testing.T) {
+		p := newBaby(100 * time.Hour)
+		records := p.UpdateLifeStage(now)
+		if len(records) != 3 {
+			t.Fatalf("Expected child, teen and adult evolutions, got %d", len(records))
+		}
+		if records[0].From != FormBaby || records[len(records)-1].To != p.Form {
+			t.Errorf("Records don't chain from baby to current form: %+v", records)
+		}
+		for i := 1; i < len(records); i++ {
+			if records[i].From != records[i-1].To {
+				t.Errorf("Record %d starts at %d, expected %d", i, records[i].From, records[i-1].To)
+			}
+		}
+		if records[2].Stage != StageAdult {
+			t.Errorf("Expected final record for adult stage, got %d", records[2].Stage)
+		}
+	})
+
+	t.Run("Announcing clears pending evolutions", func(t *testing.T) {
+		p := newBaby(50 * time.Hour)
+		p.UpdateLifeStage(now)
+		if len(p.PendingEvolutions()) != 1 {
+			t.Fatalf("Expected 1 pending evolution, got %d", len(p.PendingEvolutions()))
+		}
+		p.MarkEvolutionsAnnounced()
+		if len(p.PendingEvolutions()) != 0 {
+			t.Error("Expected no pending evolutions after announcing")
+		}
+		if records := p.UpdateLifeStage(now); len(records) != 0 {
+			t.Error("Expected repeat update in the same stage to add nothing")
+		}
+	})
+
+	t.Run("Lineage shows the evolution path", func(t *testing.T) {
+		p := newBaby(10 * time.Hour)
+		if got := GetLineageDisplay(p); !strings.Contains(got, p.GetFormName()) {
+			t.Errorf("Expected baby lineage to name the form, got %q", got)
+		}
+
+		p = newBaby(80 * time.Hour)
+		p.UpdateLifeStage(now)
+		got := GetLineageDisplay(p)
+		if strings.Count(got, "→") != 2 {
+			t.Errorf("Expected two arrows for baby → child → teen, got %q", got)
+		}
+		if !strings.HasSuffix(got, p.GetFormEmoji()) {
+			t.Errorf("Expected lineage to end at current form %s, got %q", p.GetFormEmoji(), got)
+		}
+	})
+
+	t.Run("History persists across save and load", func(t *testing.T) {
+		p := newBaby(50 * time.Hour)
+		p.UpdateLifeStage(now)
+		p.MarkEvolutionsAnnounced()
+
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("Failed to marshal pet: %v", err)
+		}
+		var loaded Pet
+		if err := json.Unmarshal(data, &loaded); err != nil {
+			t.Fatalf("Failed to unmarshal pet: %v", err)
+		}
+		if len(loaded.EvolutionHistory) != 1 || !loaded.EvolutionHistory[0].Announced {
+			t.Errorf("Expected announced record to round-trip, got %+v", loaded.EvolutionHistory)
+		}
+	})
+}
+
+func TestMemorial(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+
+	now := mockTimeNow(t)
+
+	deadPet := func(name string, age, bond int, cause string) Pet {
+		born := now.Add(-time.Duration(age) * time.Hour)
+		return Pet{
+			Name:         name,
+			Age:          age,
+			Form:         FormHealthyChild,
+			Dead:         true,
+			CauseOfDeath: cause,
+			Bond:         bond,
+			Chronotype:   ChronotypeNightOwl,
+			Traits:       []Trait{{Name: "Playful", Category: "temperament"}},
+			LastSaved:    now,
+			Logs:         []LogEntry{{Time: born}},
+			EvolutionHistory: []EvolutionRecord{
+				{From: FormBaby, To: FormHealthyChild, Stage: StageChild, Time: born.Add(ChildStageAge * time.Hour), CareQuality: 90},
+			},
+			Tricks:   []Trick{{Name: "Sit", Proficiency: TrickMasteredThreshold}},
+			EventLog: []EventLogEntry{{Type: EventChasing}, {Type: EventChasing}, {Type: EventScared, WasIgnored: true}},
+		}
+	}
+
+	t.Run("Empty memorial when nothing archived", func(t *testing.T) {
+		m := LoadMemorial()
+		if len(m.Pets) != 0 {
+			t.Errorf("Expected empty memorial, got %d pets", len(m.Pets))
+		}
+		if records := m.Records(); records.TotalPets != 0 || records.LongestLived != nil {
+			t.Errorf("Expected empty records, got %+v", records)
+		}
+	})
+
+	t.Run("Living pets are not archived", func(t *testing.T) {
+		p := deadPet("Alive", 10, 50, "")
+		p.Dead = false
+		if ArchivePet(p) {
+			t.Error("Expected living pet to be skipped")
+		}
+	})
+
+	t.Run("Pets killed with cheats are not archived", func(t *testing.T) {
+		if ArchivePet(deadPet("Cheater", 10, 50, CheatDeathCause)) {
+			t.Error("Expected a cheat kill to stay out of the memorial")
+		}
+	})
+
+	t.Run("Archives a dead pet's life summary once", func(t *testing.T) {
+		p := deadPet("Milo", 60, 80, "Starvation")
+		if !ArchivePet(p) {
+			t.Fatal("Expected pet to be archived")
+		}
+		if ArchivePet(p) {
+			t.Error("Expected second archive of the same pet to be skipped")
+		}
+
+		m := LoadMemorial()
+		if len(m.Pets) != 1 {
+			t.Fatalf("Expected 1 archived pet, got %d", len(m.Pets))
+		}
+		entry := m.Pets[0]
+		if entry.Name != "Milo" || entry.LifespanHours != 60 || entry.CauseOfDeath != "Starvation" {
+			t.Errorf("Unexpected entry: %+v", entry)
+		}
+		if entry.FinalForm != FormHealthyChild || len(entry.Lineage) != 2 {
+			t.Errorf("Expected lineage baby → healthy child, got %v", entry.Lineage)
+		}
+		if len(entry.Traits) != 1 || entry.Traits[0] != "Playful" {
+			t.Errorf("Expected traits to be archived, got %v", entry.Traits)
+		}
+
+		notable := strings.Join(entry.NotableEvents, "\n")
+		for _, want := range []string{"Became Healthy Child", "Mastered Sit", "Shared 2 moments", "butterfly"} {
+			if !strings.Contains(notable, want) {
+				t.Errorf("Expected notable events to mention %q, got %q", want, notable)
+			}
+		}
+	})
+
+	t.Run("Records find longest lived and most bonded", func(t *testing.T) {
+		ArchivePet(deadPet("Old", 200, 40, "Old Age"))
+		ArchivePet(deadPet("Loved", 30, 95, "Starvation"))
+
+		records := LoadMemorial().Records()
+		if records.TotalPets != 3 {
+			t.Errorf("Expected 3 pets, got %d", records.TotalPets)
+		}
+		if records.LongestLived.Name != "Old" {
+			t.Errorf("Expected Old to be longest lived, got %s", records.LongestLived.Name)
+		}
+		if records.MostBonded.Name != "Loved" {
+			t.Errorf("Expected Loved to be most bonded, got %s", records.MostBonded.Name)
+		}
+		if records.MostCommonCause != "Starvation" {
+			t.Errorf("Expected Starvation as most common cause, got %s", records.MostCommonCause)
+		}
+		if records.AverageLifespan != (60+200+30)/3 {
+			t.Errorf("Unexpected average lifespan %d", records.AverageLifespan)
+		}
+	})
+
+	t.Run("Archives the time and age of death, not of the last save", func(t *testing.T) {
+		p := deadPet("Late", 50, 60, "Neglect")
+		p.Die("Neglect", now)
+		p.Age += 30
+		p.LastSaved = now.Add(30 * time.Hour)
+
+		entry := NewMemorialEntry(p)
+		if !entry.Died.Equal(now) || entry.LifespanHours != 50 {
+			t.Errorf("Expected death at %s aged 50h, got %s aged %dh", now, entry.Died, entry.LifespanHours)
+		}
+	})
+
+	t.Run("Unknown logged events don't break notable events", func(t *testing.T) {
+		p := deadPet("Retro", 20, 50, "Neglect")
+		p.EventLog = []EventLogEntry{{Type: "retired_event"}}
+		notable := strings.Join(NewMemorialEntry(p).NotableEvents, "\n")
+		if !strings.Contains(notable, "Shared 1 moments") {
+			t.Errorf("Expected the moment to still be counted, got %q", notable)
+		}
+	})
+
+	t.Run("Saving archives pets that die, loading alone doesn't", func(t *testing.T) {
+		os.Remove(GetMemorialPath())
+
+		criticalStart := now.Add(-13 * time.Hour)
+		p := NewPet(&TestConfig{
+			InitialHunger:    5,
+			InitialHappiness: 5,
+			InitialEnergy:    5,
+			Health:           10,
+			LastSavedTime:    criticalStart,
+		})
+		p.CriticalStartTime = &criticalStart
+		data, err := json.Marshal(p)
+		if err != nil {
+			t.Fatalf("Failed to marshal pet: %v", err)
+		}
+		if err := os.WriteFile(TestConfigPath, data, 0644); err != nil {
+			t.Fatalf("Failed to write test file: %v", err)
+		}
+
+		loaded := LoadState()
+		if !loaded.Dead {
+			t.Fatal("Expected pet to die in critical state")
+		}
+		if _, err := os.Stat(GetMemorialPath()); !os.IsNotExist(err) {
+			t.Error("Expected a read-only load not to write the memorial")
+		}
+
+		SaveState(&loaded)
+		m := LoadMemorial()
+		if len(m.Pets) != 1 || m.Pets[0].CauseOfDeath != loaded.CauseOfDeath {
+			t.Errorf("Expected dead pet in memorial, got %+v", m.Pets)
+		}
+	})
+}
+
+func TestHeredity(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+
+	now := mockTimeNow(t)
+	originalRandFloat64 := RandFloat64
+	defer func() { RandFloat64 = originalRandFloat64 }()
+
+	parentTraits := func() []Trait {
+		RandFloat64 = func() float64 { return 0.0 }
+		return GenerateTraits()
+	}
+
+	newParent := func(care int) Pet {
+		return Pet{
+			Name:         "Milo",
+			Form:         FormEliteAdult,
+			Dead:         true,
+			CauseOfDeath: "Old Age",
+			Chronotype:   ChronotypeEarlyBird,
+			Traits:       parentTraits(),
+			LastSaved:    now,
+			Logs:         []LogEntry{{Time: now.Add(-200 * time.Hour)}},
+			StatCheckpoints: map[string][]StatCheck{
+				"stage_2": {{Hunger: care, Happiness: care, Energy: care, Health: care, Cleanliness: care}},
+			},
+		}
+	}
+
+	hasTrait := func(p Pet, name string) bool {
+		for _, trait := range p.Traits {
+			if trait.Name == name {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("Inherits traits and chronotype when the rolls succeed", func(t *testing.T) {
+		parent := newParent(100)
+		RandFloat64 = func() float64 { return 0.0 }
+		heir := NewHeir(parent)
+
+		for _, trait := range parent.Traits {
+			if !hasTrait(heir, trait.Name) {
+				t.Errorf("Expected heir to inherit %s", trait.Name)
+			}
+		}
+		if heir.Chronotype != ChronotypeEarlyBird {
+			t.Errorf("Expected inherited chronotype, got %s", heir.Chronotype)
+		}
+		if len(heir.Traits) != len(parent.Traits) {
+			t.Errorf("Expected one trait per category, got %d", len(heir.Traits))
+		}
+	})
+
+	t.Run("Keeps fresh traits when the rolls fail", func(t *testing.T) {
+		parent := newParent(100)
+		RandFloat64 = func() float64 { return 0.99 }
+		heir := NewHeir(parent)
+
+		if hasTrait(heir, "Calm") || hasTrait(heir, "Picky") {
+			t.Errorf("Expected no inherited traits, got %+v", heir.Traits)
+		}
+		if heir.Chronotype != ChronotypeNightOwl {
+			t.Errorf("Expected freshly rolled chronotype, got %s", heir.Chronotype)
+		}
+	})
+
+	t.Run("Bond bonus scales with the parent's care", func(t *testing.T) {
+		RandFloat64 = func() float64 { return 0.5 }
+		great := NewHeir(newParent(100))
+		poor := NewHeir(newParent(PoorCareThreshold))
+
+		if great.Bond != InitialBond+MaxInheritedBondBonus {
+			t.Errorf("Expected max bond bonus for perfect care, got bond %d", great.Bond)
+		}
+		if poor.Bond != InitialBond {
+			t.Errorf("Expected no bond bonus for poor care, got bond %d", poor.Bond)
+		}
+		if mid := GetInheritedBondBonus(70); mid <= 0 || mid >= MaxInheritedBondBonus {
+			t.Errorf("Expected partial bonus for decent care, got %d", mid)
+		}
+	})
+
+	t.Run("Records the family tree", func(t *testing.T) {
+		RandFloat64 = func() float64 { return 0.5 }
+		grandparent := newParent(80)
+		grandparent.Name = "Ada"
+		parent := NewHeir(grandparent)
+		parent.Name = "Milo"
+		parent.Dead = true
+		child := NewHeir(parent)
+
+		if child.Generation() != 3 {
+			t.Errorf("Expected generation 3, got %d", child.Generation())
+		}
+		if child.Parent() == nil || child.Parent().Name != "Milo" {
+			t.Errorf("Expected Milo as parent, got %+v", child.Parent())
+		}
+		if child.Ancestry[0].Name != "Ada" || child.Ancestry[0].CareQuality != 80 {
+			t.Errorf("Expected Ada as founder with 80%% care, got %+v", child.Ancestry[0])
+		}
+		if got := GetFamilyDisplay(child); got != "Gen 3, heir of Milo" {
+			t.Errorf("Unexpected family display %q", got)
+		}
+		if got := GetFamilyTreeDisplay(child); !strings.HasPrefix(got, "⭐ Ada → ") {
+			t.Errorf("Expected tree to start at the founder, got %q", got)
+		}
+		if len(grandparent.Ancestry) != 0 {
+			t.Error("Expected creating an heir not to modify the parent's ancestry")
+		}
+	})
+
+	t.Run("Records when the parent died, not when it was last saved", func(t *testing.T) {
+		RandFloat64 = func() float64 { return 0.5 }
+		parent := newParent(80)
+		diedAt := now.Add(-48 * time.Hour)
+		parent.DiedAt = &diedAt
+		if heir := NewHeir(parent); !heir.Parent().Died.Equal(diedAt) {
+			t.Errorf("Expected death date %s, got %s", diedAt, heir.Parent().Died)
+		}
+
+		legacy := NewHeir(newParent(80))
+		if !legacy.Parent().Died.Equal(now) {
+			t.Errorf("Expected legacy saves to fall back to the last save, got %s", legacy.Parent().Died)
+		}
+	})
+
+	t.Run("First generation pets have no parent", func(t *testing.T) {
+		p := NewPet(nil)
+		if p.Parent() != nil || GetFamilyDisplay(p) != "Gen 1" {
+			t.Errorf("Expected a founder, got %q", GetFamilyDisplay(p))
+		}
+	})
+}
+
+func TestTraitRegistry(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	mockTimeNow(t)
+
+	originalRandFloat64 := RandFloat64
+	defer func() { RandFloat64 = originalRandFloat64 }()
+
+	withTraits := func(names ...string) Pet {
+		p := Pet{Hunger: 50, Happiness: 80, Energy: 80, Health: 100, Bond: 50}
+		for _, name := range names {
+			def := GetTraitDefinition(name)
+			if def == nil {
+				t.Fatalf("Trait %s is not registered", name)
+			}
+			p.Traits = append(p.Traits, def.Trait())
+		}
+		return p
+	}
+
+	t.Run("Older saves gain the newer categories on load", func(t *testing.T) {
+		RandFloat64 = func() float64 { return 0.0 }
+		p := NewPet(&TestConfig{InitialHunger: 80, InitialHappiness: 80, InitialEnergy: 80, Health: 80, LastSavedTime: TimeNow()})
+		p.Traits = withTraits("Calm", "Picky").Traits
+		data, _ := json.Marshal(p)
+		os.WriteFile(TestConfigPath, data, 0644)
+
+		loaded := LoadState()
+		if len(loaded.Traits) != len(GetTraitCategories()) {
+			t.Fatalf("Expected a trait in every category, got %+v", loaded.Traits)
+		}
+		if loaded.Traits[0].Name != "Calm" || loaded.Traits[1].Name != "Picky" {
+			t.Errorf("Expected the original traits to be kept, got %+v", loaded.Traits[:2])
+		}
+	})
+
+	t.Run("Every category has two common traits and registered names are unique", func(t *testing.T) {
+		seen := make(map[string]bool)
+		for _, def := range GetTraitDefinitions() {
+			if seen[def.Name] {
+				t.Errorf("Duplicate trait %s", def.Name)
+			}
+			seen[def.Name] = true
+		}
+		for _, category := range GetTraitCategories() {
+			common := 0
+			for _, def := range GetTraitsInCategory(category) {
+				if !def.Rare {
+					common++
+				}
+			}
+			if common < 2 {
+				t.Errorf("Category %s has %d common traits, want at least 2", category, common)
+			}
+		}
+	})
+
+	t.Run("High rolls land on rare traits", func(t *testing.T) {
+		RandFloat64 = func() float64 { return 0.99 }
+		p := Pet{Traits: GenerateTraits()}
+		for _, name := range []string{"Stubborn", "Gourmet", "Lap Cat", "Iron Stomach", "Explorer", "Fearless", "Songbird"} {
+			if !p.HasTrait(name) {
+				t.Errorf("Expected rare trait %s with a 0.99 roll", name)
+			}
+		}
+		if !strings.Contains(GetTraitsDisplay(p), "Fearless✨") {
+			t.Errorf("Expected rare traits to be marked, got %q", GetTraitsDisplay(p))
+		}
+	})
+
+	t.Run("Traits change event probabilities", func(t *testing.T) {
+		timid := withTraits("Timid")
+		if mod := timid.GetEventChanceModifier(EventScared); mod != 2.0 {
+			t.Errorf("Expected Timid to double scare chance, got %.2f", mod)
+		}
+		curious := withTraits("Curious", "Explorer")
+		if mod := curious.GetEventChanceModifier(EventFoundSomething); mod != 1.5*2.5 {
+			t.Errorf("Expected modifiers to stack, got %.2f", mod)
+		}
+
+		RandFloat64 = func() float64 { return 0.0 }
+		p := withTraits("Fearless")
+		p.Happiness = 50
+		p.Energy = 10 // Too tired for chasing or found events
+		p.Mood = "normal"
+		TriggerRandomEvent(&p, QuietSchedule{})
+		if p.CurrentEvent != nil && p.CurrentEvent.Type == EventScared {
+			t.Error("Expected Fearless pet never to get scared")
+		}
+	})
+
+	t.Run("Traits change mood transition weights", func(t *testing.T) {
+		// Rested, content pet: base weights normal 0.6, playful 0.2, lazy 0.1, needy 0.1
+		RandFloat64 = func() float64 { return 0.85 }
+		plain := withTraits()
+		ApplyAutonomousBehavior(&plain)
+		if plain.Mood != "lazy" {
+			t.Fatalf("Expected lazy without traits, got %s", plain.Mood)
+		}
+
+		// Hyperactive widens playful and shrinks lazy, so the same roll lands on playful
+		hyper := withTraits("Hyperactive")
+		ApplyAutonomousBehavior(&hyper)
+		if hyper.Mood != "playful" {
+			t.Errorf("Expected Hyperactive pet to turn playful, got %s", hyper.Mood)
+		}
+
+		legacy := Pet{Traits: []Trait{{Name: "Retired Trait"}}}
+		if legacy.GetMoodWeightModifier("lazy") != 1.0 {
+			t.Error("Expected unknown traits to leave mood weights alone")
+		}
+	})
+
+	t.Run("Traits can refuse actions", func(t *testing.T) {
+		stubborn := withTraits("Stubborn")
+		stubborn.Bond = StubbornTrainBond - 1
+		if _, refused := stubborn.CheckTraitRefusal("train"); !refused {
+			t.Error("Expected Stubborn pet to refuse training at low bond")
+		}
+		if msg, ok := stubborn.Train(); ok || !strings.Contains(msg, "Refuses") {
+			t.Errorf("Expected Train to surface the refusal, got %q", msg)
+		}
+		stubborn.Bond = StubbornTrainBond
+		if _, refused := stubborn.CheckTraitRefusal("train"); refused {
+			t.Error("Expected Stubborn pet to train once bonded")
+		}
+
+		sleepy := withTraits("Sleepyhead")
+		sleepy.Energy = SleepyheadPlayEnergy - 1
+		if _, refused := sleepy.CheckTraitRefusal("play"); !refused {
+			t.Error("Expected Sleepyhead to refuse play when low on energy")
+		}
+		if _, refused := sleepy.CheckTraitRefusal("feed"); refused {
+			t.Error("Expected Sleepyhead refusal to apply only to play")
+		}
+
+		gourmet := withTraits("Gourmet")
+		gourmet.Hunger = GourmetFeedHunger
+		if _, refused := gourmet.CheckTraitRefusal("feed"); !refused {
+			t.Error("Expected Gourmet to refuse food when not hungry")
+		}
+	})
+}
+
+func TestTraitDrift(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNow(t)
+
+	newPet := func() Pet {
+		calm := GetTraitDefinition("Calm").Trait()
+		brave := GetTraitDefinition("Brave").Trait()
+		return Pet{Traits: []Trait{calm, brave}, Happiness: 60, Energy: 80, Hunger: 80, Health: 100}
+	}
+
+	t.Run("Ignored scares gradually make a pet timid", func(t *testing.T) {
+		p := newPet()
+		for i := 1; i < TimidDriftThreshold; i++ {
+			if change := p.AddTraitExperience(ExperienceIgnoredScare); change != nil {
+				t.Fatalf("Trait changed too early after %d scares", i)
+			}
+		}
+		if got := GetTraitDriftDisplay(p); !strings.Contains(got, "Timid") {
+			t.Errorf("Expected drift display to show Timid progress, got %q", got)
+		}
+
+		change := p.AddTraitExperience(ExperienceIgnoredScare)
+		if change == nil || change.From != "Brave" || change.To != "Timid" {
+			t.Fatalf("Expected Brave → Timid, got %+v", change)
+		}
+		if !p.HasTrait("Timid") || p.HasTrait("Brave") {
+			t.Error("Expected Timid to replace Brave in the courage category")
+		}
+		if len(p.TraitHistory) != 1 || p.TraitProgress["Timid"] != 0 {
+			t.Errorf("Expected change logged and progress cleared, got %+v / %v", p.TraitHistory, p.TraitProgress)
+		}
+		if p.AddTraitExperience(ExperienceIgnoredScare) != nil {
+			t.Error("Expected no further change once Timid")
+		}
+	})
+
+	t.Run("Comforting scares undoes progress toward timid", func(t *testing.T) {
+		p := newPet()
+		p.AddTraitExperience(ExperienceIgnoredScare)
+		p.AddTraitExperience(ExperienceComfortedScare)
+		if p.TraitProgress["Timid"] != 0 {
+			t.Errorf("Expected comforting to reduce progress, got %d", p.TraitProgress["Timid"])
+		}
+	})
+
+	t.Run("Event outcomes feed trait drift", func(t *testing.T) {
+		originalRandFloat64 := RandFloat64
+		defer func() { RandFloat64 = originalRandFloat64 }()
+		RandFloat64 = func() float64 { return 0.99 } // No new events
+
+		p := newPet()
+		p.CurrentEvent = &Event{Type: EventScared, StartTime: now.Add(-10 * time.Minute), ExpiresAt: now.Add(-time.Minute)}
+		TriggerRandomEvent(&p, QuietSchedule{})
+		if p.TraitProgress["Timid"] != 1 {
+			t.Errorf("Expected ignored scare to count toward Timid, got %d", p.TraitProgress["Timid"])
+		}
+
+		p.CurrentEvent = &Event{Type: EventScared, StartTime: now, ExpiresAt: now.Add(5 * time.Minute)}
+		p.RespondToEvent()
+		if p.TraitProgress["Timid"] != 0 {
+			t.Errorf("Expected comforted scare to count against Timid, got %d", p.TraitProgress["Timid"])
+		}
+	})
+
+	t.Run("Lots of play makes a pet hyperactive", func(t *testing.T) {
+		p := newPet()
+		var change *TraitChange
+		for i := 0; i < HyperactiveDriftThreshold; i++ {
+			change = p.AddTraitExperience(ExperiencePlay)
+		}
+		if change == nil || change.From != "Calm" || change.To != "Hyperactive" {
+			t.Errorf("Expected Calm → Hyperactive, got %+v", change)
+		}
+	})
+
+	t.Run("Well-timed care unlocks Trusting as an extra trait", func(t *testing.T) {
+		p := newPet()
+		for i := 0; i < TrustingDriftThreshold; i++ {
+			p.AddTraitExperience(ExperienceWellTimedCare)
+		}
+		if !p.HasTrait("Trusting") || len(p.Traits) != 3 {
+			t.Errorf("Expected Trusting to be added alongside birth traits, got %+v", p.Traits)
+		}
+		if p.TraitHistory[0].From != "" || !strings.Contains(p.TraitHistory[0].Describe(), "became Trusting") {
+			t.Errorf("Unexpected history entry %+v", p.TraitHistory[0])
+		}
+		if p.GetMoodWeightModifier("playful") != 1.2 {
+			t.Error("Expected Trusting to affect behavior through the registry")
+		}
+	})
+
+	t.Run("Trusting is never rolled at birth", func(t *testing.T) {
+		originalRandFloat64 := RandFloat64
+		defer func() { RandFloat64 = originalRandFloat64 }()
+		for _, roll := range []float64{0, 0.5, 0.99} {
+			RandFloat64 = func() float64 { return roll }
+			p := Pet{Traits: GenerateTraits()}
+			if p.HasTrait("Trusting") {
+				t.Errorf("Trusting was rolled with %.2f", roll)
+			}
+		}
+	})
+}
+
+func TestFoodInventory(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNow(t)
+
+	newHungryPet := func() Pet {
+		p := Pet{Hunger: 40, Happiness: 50, Energy: 80, Health: 80, Bond: MaxBond}
+		p.RestockPantry(now)
+		return p
+	}
+
+	t.Run("Foods have distinct effects", func(t *testing.T) {
+		seen := make(map[string]bool)
+		for _, food := range GetFoodDefinitions() {
+			key := fmt.Sprintf("%d/%d/%d", food.Hunger, food.Happiness, food.Health)
+			if seen[key] {
+				t.Errorf("Food %s duplicates another food's effects", food.Name)
+			}
+			seen[key] = true
+		}
+	})
+
+	t.Run("Kibble matches the classic feed", func(t *testing.T) {
+		p := newHungryPet()
+		if _, ok := p.Feed(FoodKibble); !ok {
+			t.Fatal("Expected pet to eat kibble")
+		}
+		if p.Hunger != 40+FeedHungerIncrease || p.Happiness != 50+FeedHappinessIncrease || p.Health != 80 {
+			t.Errorf("Unexpected stats after kibble: hunger %d, happiness %d, health %d", p.Hunger, p.Happiness, p.Health)
+		}
+		if p.Inventory[FoodKibble] != GetFoodDefinition(FoodKibble).DailyRation-1 {
+			t.Errorf("Expected one kibble used, have %d", p.Inventory[FoodKibble])
+		}
+		if last := p.LastInteractions[len(p.LastInteractions)-1]; last.Type != "feed" || last.Food != FoodKibble {
+			t.Errorf("Expected feed interaction recording the food, got %+v", last)
+		}
+	})
+
+	t.Run("Healthy and junk foods affect health", func(t *testing.T) {
+		p := newHungryPet()
+		p.Feed(FoodVegetables)
+		if p.Health != 80+GetFoodDefinition(FoodVegetables).Health {
+			t.Errorf("Expected vegetables to restore health, got %d", p.Health)
+		}
+
+		p = newHungryPet()
+		p.Feed(FoodTreat)
+		if p.Health >= 80 {
+			t.Errorf("Expected a treat to cost a little health, got %d", p.Health)
+		}
+	})
+
+	t.Run("Too much junk food hurts", func(t *testing.T) {
+		p := newHungryPet()
+		p.Inventory[FoodTreat] = 5
+		for i := 0; i < JunkFoodLimit; i++ {
+			p.Hunger = 20
+			if msg, _ := p.Feed(FoodTreat); strings.Contains(msg, "junk") {
+				t.Fatalf("Junk penalty applied too early on treat %d", i+1)
+			}
+		}
+		p.Hunger = 20
+		healthBefore := p.Health
+		msg, _ := p.Feed(FoodTreat)
+		if !strings.Contains(msg, "junk") || p.Health != healthBefore+GetFoodDefinition(FoodTreat).Health-JunkFoodHealthPenalty {
+			t.Errorf("Expected junk food penalty, got %q and health %d", msg, p.Health)
+		}
+	})
+
+	t.Run("Overfeeding hurts", func(t *testing.T) {
+		p := newHungryPet()
+		p.Hunger = OverfeedThreshold
+		msg, ok := p.Feed(FoodKibble)
+		if !ok || !strings.Contains(msg, "too much") || p.Health != 80-OverfeedHealthPenalty {
+			t.Errorf("Expected overfeeding penalty, got %q and health %d", msg, p.Health)
+		}
+	})
+
+	t.Run("Refuses when out of stock, full or unknown", func(t *testing.T) {
+		p := newHungryPet()
+		p.Inventory[FoodFish] = 0
+		if _, ok := p.Feed(FoodFish); ok {
+			t.Error("Expected no fish when out of stock")
+		}
+		if _, ok := p.Feed("pizza"); ok {
+			t.Error("Expected unknown food to be rejected")
+		}
+		p.Hunger = 95
+		if _, ok := p.Feed(FoodKibble); ok {
+			t.Error("Expected a full pet to refuse food")
+		}
+	})
+
+	t.Run("Picky pets refuse vegetables", func(t *testing.T) {
+		p := newHungryPet()
+		p.Traits = []Trait{GetTraitDefinition("Picky").Trait()}
+		msg, ok := p.Feed(FoodVegetables)
+		if ok || !strings.Contains(msg, "vegetables") {
+			t.Errorf("Expected Picky pet to refuse vegetables, got %q", msg)
+		}
+		if p.Inventory[FoodVegetables] != GetFoodDefinition(FoodVegetables).DailyRation {
+			t.Error("Expected refused food to stay in the pantry")
+		}
+		if _, ok := p.Feed(FoodFish); !ok {
+			t.Error("Expected Picky pet to still eat fish")
+		}
+	})
+
+	t.Run("Pantry restocks daily up to the cap", func(t *testing.T) {
+		p := newHungryPet()
+		p.Inventory[FoodFish] = 0
+		p.RestockPantry(now.Add(23 * time.Hour))
+		if p.Inventory[FoodFish] != 0 {
+			t.Error("Expected no restock before a full day")
+		}
+		p.RestockPantry(now.Add(49 * time.Hour))
+		if p.Inventory[FoodFish] != 2 {
+			t.Errorf("Expected two days of fish, got %d", p.Inventory[FoodFish])
+		}
+		p.RestockPantry(now.Add(30 * 24 * time.Hour))
+		for _, food := range GetFoodDefinitions() {
+			if p.Inventory[food.Type] != food.MaxStock {
+				t.Errorf("Expected %s capped at %d, got %d", food.Name, food.MaxStock, p.Inventory[food.Type])
+			}
+		}
+	})
+}
+
+func TestPlayActivities(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	mockTimeNow(t)
+
+	newPlayfulPet := func() Pet {
+		return Pet{Hunger: 80, Happiness: 40, Energy: 90, Health: 80, Bond: MaxBond, Age: PuzzleUnlockAge, Mood: "normal"}
+	}
+
+	t.Run("Activities have distinct effects", func(t *testing.T) {
+		seen := make(map[string]bool)
+		for _, def := range GetPlayActivityDefinitions() {
+			key := fmt.Sprintf("%d/%d/%d", def.Happiness, def.Energy, def.Hunger)
+			if seen[key] {
+				t.Errorf("Activity %s duplicates another activity's effects", def.Name)
+			}
+			seen[key] = true
+		}
+	})
+
+	t.Run("Fetch matches the classic play", func(t *testing.T) {
+		p := newPlayfulPet()
+		if _, ok := p.Play(PlayFetch); !ok {
+			t.Fatal("Expected pet to play fetch")
+		}
+		if p.Happiness != 40+PlayHappinessIncrease || p.Energy != 90-PlayEnergyDecrease || p.Hunger != 80-PlayHungerDecrease {
+			t.Errorf("Unexpected stats after fetch: happiness %d, energy %d, hunger %d", p.Happiness, p.Energy, p.Hunger)
+		}
+		if last := p.LastInteractions[len(p.LastInteractions)-1]; last.Activity != PlayFetch {
+			t.Errorf("Expected interaction to record fetch, got %q", last.Activity)
+		}
+	})
+
+	t.Run("Locked activities explain how to unlock them", func(t *testing.T) {
+		p := newPlayfulPet()
+		p.Bond = 0
+		p.Age = 0
+		for _, activity := range []string{PlayTug, PlayPuzzle, PlayLaser} {
+			msg, ok := p.Play(activity)
+			if ok {
+				t.Errorf("Expected %s to be locked", activity)
+			}
+			if !strings.Contains(msg, "unlocks at") {
+				t.Errorf("Expected unlock hint for %s, got %q", activity, msg)
+			}
+		}
+		if !strings.Contains(GetPlayMenuLabel(p, *GetPlayActivityDefinition(PlayLaser)), "🔒") {
+			t.Error("Expected locked activity to show a lock in the menu")
+		}
+		if _, ok := p.Play(PlayFetch); !ok {
+			t.Error("Expected fetch to always be unlocked")
+		}
+	})
+
+	t.Run("Puzzle can be played in a lazy mood", func(t *testing.T) {
+		p := newPlayfulPet()
+		p.Mood = "lazy"
+		p.Energy = 40
+		if _, ok := p.Play(PlayFetch); ok {
+			t.Error("Expected lazy pet to refuse fetch")
+		}
+		if _, ok := p.Play(PlayPuzzle); !ok {
+			t.Error("Expected lazy pet to play with the puzzle toy")
+		}
+	})
+
+	t.Run("Mood and traits change the happiness gained", func(t *testing.T) {
+		plain := newPlayfulPet()
+		plain.Play(PlayLaser)
+
+		playful := newPlayfulPet()
+		playful.Mood = "playful"
+		playful.Play(PlayLaser)
+		if playful.Happiness <= plain.Happiness {
+			t.Errorf("Expected playful mood to enjoy the laser more: %d vs %d", playful.Happiness, plain.Happiness)
+		}
+
+		calm := newPlayfulPet()
+		calm.Traits = []Trait{{Name: "Calm", Category: "temperament"}}
+		calm.Play(PlayPuzzle)
+		hyper := newPlayfulPet()
+		hyper.Traits = []Trait{{Name: "Hyperactive", Category: "temperament"}}
+		hyper.Play(PlayPuzzle)
+		if calm.Happiness <= hyper.Happiness {
+			t.Errorf("Expected calm pet to enjoy the puzzle more: %d vs %d", calm.Happiness, hyper.Happiness)
+		}
+	})
+
+	t.Run("Tug of war builds extra bond", func(t *testing.T) {
+		fetch := newPlayfulPet()
+		fetch.Bond = LaserUnlockBond
+		fetch.Play(PlayFetch)
+		tug := newPlayfulPet()
+		tug.Bond = LaserUnlockBond
+		tug.Play(PlayTug)
+		if tug.Bond != fetch.Bond+1 {
+			t.Errorf("Expected tug of war to give one extra bond: %d vs %d", tug.Bond, fetch.Bond)
+		}
+	})
+}
+
+func TestHygiene(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNow(t)
+
+	saveAt := func(p Pet, lastSaved time.Time) {
+		p.LastSaved = lastSaved
+		data, _ := json.MarshalIndent(p, "", "  ")
+		os.WriteFile(TestConfigPath, data, 0644)
+	}
+
+	t.Run("Cleanliness decays over time, slower while sleeping", func(t *testing.T) {
+		awake := NewPet(&TestConfig{InitialHunger: 100, InitialHappiness: 100, InitialEnergy: 100, Health: 100, LastSavedTime: now.Add(-10 * time.Hour)})
+		awake.Traits = nil
+		saveAt(awake, now.Add(-10*time.Hour))
+		loaded := LoadState()
+		if loaded.Cleanliness != MaxStat-10*CleanlinessDecreaseRate {
+			t.Errorf("Expected cleanliness %d after 10 awake hours, got %d", MaxStat-10*CleanlinessDecreaseRate, loaded.Cleanliness)
+		}
+
+		asleep := NewPet(&TestConfig{InitialHunger: 100, InitialHappiness: 100, InitialEnergy: 50, Health: 100, IsSleeping: true, LastSavedTime: now.Add(-10 * time.Hour)})
+		asleep.Traits = nil
+		saveAt(asleep, now.Add(-10*time.Hour))
+		loaded = LoadState()
+		if loaded.Cleanliness != MaxStat-10*SleepingCleanlinessRate {
+			t.Errorf("Expected cleanliness %d after 10 sleeping hours, got %d", MaxStat-10*SleepingCleanlinessRate, loaded.Cleanliness)
+		}
+	})
+
+	t.Run("Saves from before hygiene start spotless", func(t *testing.T) {
+		legacy := `{"name":"Old","hunger":80,"happiness":80,"energy":80,"health":80,"last_saved":"` +
+			now.Format(time.RFC3339) + `","logs":[{"time":"` + now.Add(-time.Hour).Format(time.RFC3339) + `"}],` +
+			`"stat_checkpoints":{"stage_0":[{"hunger":80,"happiness":80,"energy":80,"health":80}]}}`
+		if err := os.WriteFile(TestConfigPath, []byte(legacy), 0644); err != nil {
+			t.Fatal(err)
+		}
+		p := LoadState()
+		if p.Cleanliness != MaxStat {
+			t.Errorf("Expected legacy pet to be clean, got %d", p.Cleanliness)
+		}
+		if care := p.CalculateCareQuality(StageBaby); care.AvgCleanliness != MaxStat {
+			t.Errorf("Expected legacy checkpoints to count as clean, got %d", care.AvgCleanliness)
+		}
+	})
+
+	t.Run("Eating makes a mess", func(t *testing.T) {
+		p := Pet{Hunger: 40, Happiness: 50, Energy: 80, Health: 80, Cleanliness: 80, Bond: MaxBond}
+		p.RestockPantry(now)
+		p.Feed(FoodFish)
+		if p.Cleanliness != 80-GetFoodDefinition(FoodFish).Mess {
+			t.Errorf("Expected fish to cost %d cleanliness, got %d", GetFoodDefinition(FoodFish).Mess, p.Cleanliness)
+		}
+	})
+
+	t.Run("Clean restores cleanliness and builds bond", func(t *testing.T) {
+		p := Pet{Cleanliness: 20, Bond: MaxBond - 10}
+		expected := 20 + int(float64(CleanEffect)*p.GetBondMultiplier())
+		msg, ok := p.Clean()
+		if !ok {
+			t.Fatalf("Expected cleaning to succeed, got %q", msg)
+		}
+		if p.Cleanliness != expected {
+			t.Errorf("Expected cleanliness %d, got %d", expected, p.Cleanliness)
+		}
+		if p.Bond != MaxBond-10+BondGainWellTimed {
+			t.Errorf("Expected well-timed bond gain, got bond %d", p.Bond)
+		}
+
+		p.Cleanliness = 50
+		p.Clean()
+		if p.Bond != MaxBond-10+BondGainWellTimed {
+			t.Errorf("Expected no bond from repeated cleaning, got bond %d", p.Bond)
+		}
+	})
+
+	t.Run("Nothing to clean when already spotless", func(t *testing.T) {
+		p := Pet{Cleanliness: CleanRefuseThreshold}
+		if _, ok := p.Clean(); ok {
+			t.Error("Expected clean pet to need no cleaning")
+		}
+	})
+
+	t.Run("Dirty environment hurts health and invites illness", func(t *testing.T) {
+		originalRandFloat64 := RandFloat64
+		RandFloat64 = func() float64 { return IllnessChance * 1.5 }
+		defer func() { RandFloat64 = originalRandFloat64 }()
+
+		p := NewPet(&TestConfig{InitialHunger: 100, InitialHappiness: 100, InitialEnergy: 100, Health: 90, LastSavedTime: now.Add(-2 * time.Hour)})
+		p.Traits = nil
+		p.Bond = 0
+		p.Cleanliness = 10
+		saveAt(p, now.Add(-2*time.Hour))
+		loaded := LoadState()
+		if loaded.Health != 90-2*DirtyHealthDecayRate {
+			t.Errorf("Expected health %d, got %d", 90-2*DirtyHealthDecayRate, loaded.Health)
+		}
+		if loaded.Disease == nil || loaded.Disease.Type != DiseaseUpsetStomach {
+			t.Errorf("Expected dirty pet to catch an upset stomach despite good health, got %+v", loaded.Disease)
+		}
+	})
+
+	t.Run("Illness risk depends on time passed, not how often the pet loads", func(t *testing.T) {
+		originalRandFloat64 := RandFloat64
+		RandFloat64 = func() float64 { return IllnessChance * 1.5 }
+		defer func() { RandFloat64 = originalRandFloat64 }()
+
+		p := NewPet(&TestConfig{InitialHunger: 100, InitialHappiness: 100, InitialEnergy: 100, Health: 90, LastSavedTime: now})
+		p.Traits = nil
+		p.Bond = 0
+		p.Cleanliness = 10
+		saveAt(p, now.Add(-5*time.Minute))
+		if loaded := LoadState(); loaded.Disease != nil {
+			t.Errorf("Expected five minutes in a mess to carry little risk, got %+v", loaded.Disease)
+		}
+	})
+
+	t.Run("Mess shows in status", func(t *testing.T) {
+		p := Pet{Hunger: 80, Happiness: 80, Energy: 80, Health: 80, Cleanliness: 10}
+		if status := GetStatus(p); !strings.Contains(status, StatusEmojiDirty) {
+			t.Errorf("Expected dirty status, got %q", status)
+		}
+		if !strings.HasSuffix(GetStatusWithLabel(p), "Dirty") {
+			t.Errorf("Expected Dirty label, got %q", GetStatusWithLabel(p))
+		}
+		if mess := GetMessDisplay(p); strings.Count(mess, StatusEmojiDirty) != 3 {
+			t.Errorf("Expected three piles of mess, got %q", mess)
+		}
+
+		p.Cleanliness = 45
+		if status := GetStatus(p); !strings.Contains(status, "🧽") {
+			t.Errorf("Expected bath want, got %q", status)
+		}
+		p.Cleanliness = MessyThreshold
+		if mess := GetMessDisplay(p); mess != "" {
+			t.Errorf("Expected no mess at %d cleanliness, got %q", MessyThreshold, mess)
+		}
+	})
+
+	t.Run("Cleanliness counts toward care quality", func(t *testing.T) {
+		p := Pet{StatCheckpoints: map[string][]StatCheck{
+			"stage_0": {{Hunger: 100, Happiness: 100, Energy: 100, Health: 100, Cleanliness: 0}},
+		}}
+		if avg := p.CalculateCareQuality(StageBaby).OverallAverage(); avg != 80 {
+			t.Errorf("Expected overall care 80, got %d", avg)
+		}
+	})
+}
+
+func TestWeight(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNow(t)
+
+	newPet := func() Pet {
+		p := Pet{Hunger: 40, Happiness: 40, Energy: 90, Health: 80, Cleanliness: MaxStat, Bond: MaxBond, Mood: "normal"}
+		p.RestockPantry(now)
+		return p
+	}
+
+	t.Run("Weight classes", func(t *testing.T) {
+		cases := map[int]string{
+			0:                        WeightFit,
+			UnderweightThreshold + 1: WeightFit,
+			UnderweightThreshold:     WeightUnderweight,
+			OverweightThreshold - 1:  WeightFit,
+			OverweightThreshold:      WeightOverweight,
+		}
+		for weight, expected := range cases {
+			p := Pet{Weight: weight}
+			if class := p.GetWeightClass().Class; class != expected {
+				t.Errorf("Weight %d: expected %s, got %s", weight, expected, class)
+			}
+		}
+	})
+
+	t.Run("Food type decides weight gain", func(t *testing.T) {
+		treat := newPet()
+		treat.Feed(FoodTreat)
+		veg := newPet()
+		veg.Feed(FoodVegetables)
+		if treat.Weight != GetFoodDefinition(FoodTreat).Weight || veg.Weight != 0 {
+			t.Errorf("Expected treats to fatten and vegetables not to: treat %d, veg %d", treat.Weight, veg.Weight)
+		}
+	})
+
+	t.Run("Frequent feeding and overfeeding pile on weight", func(t *testing.T) {
+		p := newPet()
+		p.Feed(FoodKibble)
+		single := p.Weight
+		p.Hunger = 40
+		p.Feed(FoodKibble)
+		if gained := p.Weight - single; gained != GetFoodDefinition(FoodKibble).Weight+FrequentFeedWeightGain {
+			t.Errorf("Expected repeat feed to add %d, got %d", GetFoodDefinition(FoodKibble).Weight+FrequentFeedWeightGain, gained)
+		}
+
+		full := newPet()
+		full.Hunger = OverfeedThreshold
+		full.Feed(FoodKibble)
+		if full.Weight != GetFoodDefinition(FoodKibble).Weight+OverfeedWeightGain {
+			t.Errorf("Expected overfeeding to add %d, got %d", GetFoodDefinition(FoodKibble).Weight+OverfeedWeightGain, full.Weight)
+		}
+	})
+
+	t.Run("Play burns weight", func(t *testing.T) {
+		p := newPet()
+		p.Weight = 10
+		p.Play(PlayFetch)
+		if p.Weight != 10-GetPlayActivityDefinition(PlayFetch).Exercise {
+			t.Errorf("Expected fetch to burn %d, weight is %d", GetPlayActivityDefinition(PlayFetch).Exercise, p.Weight)
+		}
+	})
+
+	t.Run("Weight drifts toward ideal and drops when hungry", func(t *testing.T) {
+		p := Pet{Hunger: 80, Weight: 10}
+		p.UpdateWeight(3 * MetabolismHours)
+		if p.Weight != 7 {
+			t.Errorf("Expected metabolism to bring weight to 7, got %d", p.Weight)
+		}
+		p.UpdateWeight(100 * MetabolismHours)
+		if p.Weight != 0 {
+			t.Errorf("Expected metabolism to stop at ideal weight, got %d", p.Weight)
+		}
+
+		p.Hunger = 10
+		p.UpdateWeight(5)
+		if p.Weight != -5*StarvingWeightLoss {
+			t.Errorf("Expected hungry pet to lose weight, got %d", p.Weight)
+		}
+
+		p.UpdateWeight(1000)
+		if p.Weight != MinWeight {
+			t.Errorf("Expected weight to bottom out at %d, got %d", MinWeight, p.Weight)
+		}
+	})
+
+	t.Run("Short updates add up", func(t *testing.T) {
+		hungry := Pet{Hunger: 10}
+		full := Pet{Hunger: 80, Weight: 10}
+		for i := 0; i <= 12*MetabolismHours; i++ {
+			hungry.UpdateWeight(5.0 / 60)
+			full.UpdateWeight(5.0 / 60)
+		}
+		if hungry.Weight != -MetabolismHours*StarvingWeightLoss {
+			t.Errorf("Expected %d hours of five-minute updates to cost weight, got %d", MetabolismHours, hungry.Weight)
+		}
+		if full.Weight != 9 {
+			t.Errorf("Expected %d hours of five-minute updates to drift one point, got %d", MetabolismHours, full.Weight)
+		}
+	})
+
+	t.Run("Unhealthy weight drains energy and invites illness", func(t *testing.T) {
+		fit := Pet{}
+		heavy := Pet{Weight: OverweightThreshold}
+		light := Pet{Weight: UnderweightThreshold}
+		for _, key := range []string{"energy_decay", "illness_chance"} {
+			if fit.GetWeightModifier(key) != 1.0 {
+				t.Errorf("Expected no %s modifier when fit", key)
+			}
+			if heavy.GetWeightModifier(key) <= 1.0 || light.GetWeightModifier(key) <= 1.0 {
+				t.Errorf("Expected %s to rise when over- or underweight", key)
+			}
+		}
+	})
+
+	t.Run("Weight changes which events happen", func(t *testing.T) {
+		heavy := Pet{Weight: OverweightThreshold}
+		if heavy.GetWeightEventModifier(EventZoomies) != 0 {
+			t.Error("Expected zoomies to be unavailable when overweight")
+		}
+		light := Pet{Weight: UnderweightThreshold}
+		if light.GetWeightEventModifier(EventAteSomething) <= 1.0 {
+			t.Error("Expected underweight pets to scavenge more")
+		}
+		fit := Pet{}
+		if fit.GetWeightEventModifier(EventZoomies) != 1.0 {
+			t.Error("Expected no event modifier when fit")
+		}
+	})
+}
+
+func TestIllnesses(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNow(t)
+
+	at := func(d time.Duration) {
+		TimeNow = func() time.Time { return now.Add(d) }
+	}
+	t.Cleanup(func() { TimeNow = func() time.Time { return now } })
+
+	t.Run("Symptoms appear after incubation and drain specific stats", func(t *testing.T) {
+		at(0)
+		p := Pet{Hunger: 80, Happiness: 80, Energy: 80, Health: 80}
+		if !p.CatchDisease(DiseaseCold) {
+			t.Fatal("Expected pet to catch a cold")
+		}
+		if p.Illness {
+			t.Error("Expected no symptoms during incubation")
+		}
+		if GetIllnessDisplay(p) != "No" {
+			t.Errorf("Expected incubating illness to be hidden, got %q", GetIllnessDisplay(p))
+		}
+
+		p.UpdateDisease(QuietSchedule{}, now, now.Add(4*time.Hour))
+		if !p.Illness {
+			t.Fatal("Expected symptoms after incubation")
+		}
+		// Two symptomatic hours of a cold: -2 energy/hr, -1 happiness/hr
+		if p.Energy != 76 || p.Happiness != 78 || p.Hunger != 80 || p.Health != 80 {
+			t.Errorf("Unexpected cold symptoms: energy %d, happiness %d, hunger %d, health %d",
+				p.Energy, p.Happiness, p.Hunger, p.Health)
+		}
+		if !strings.Contains(GetIllnessDisplay(p), "checkup") {
+			t.Errorf("Expected undiagnosed illness to need a checkup, got %q", GetIllnessDisplay(p))
+		}
+	})
+
+	t.Run("Illness runs its course", func(t *testing.T) {
+		at(0)
+		p := Pet{Hunger: 100, Happiness: 100, Energy: 100, Health: 100}
+		p.CatchDisease(DiseaseUpsetStomach)
+		def := GetDiseaseDefinition(DiseaseUpsetStomach)
+		p.UpdateDisease(QuietSchedule{}, now, now.Add(def.Incubation+def.Duration+time.Hour))
+		if p.Illness || p.Disease != nil {
+			t.Error("Expected pet to recover once the illness runs its course")
+		}
+		if p.Hunger != 100-int(def.Duration.Hours())*def.Symptoms["hunger"] {
+			t.Errorf("Expected symptoms only while ill, hunger is %d", p.Hunger)
+		}
+	})
+
+	t.Run("Only one illness at a time", func(t *testing.T) {
+		at(0)
+		p := Pet{}
+		p.CatchDisease(DiseaseFever)
+		if p.CatchDisease(DiseaseCold) || p.Disease.Type != DiseaseFever {
+			t.Error("Expected existing illness to block a new one")
+		}
+	})
+
+	t.Run("Treatment takes a full course of doses", func(t *testing.T) {
+		at(0)
+		p := Pet{Health: 40, Bond: MaxBond}
+		p.CatchDisease(DiseaseFever)
+		p.Checkup()
+		def := GetDiseaseDefinition(DiseaseFever)
+
+		for dose := 1; dose <= def.Doses; dose++ {
+			at(time.Duration(dose-1) * def.DoseInterval)
+			msg, ok := p.GiveMedicine()
+			if !ok {
+				t.Fatalf("Expected dose %d to be given", dose)
+			}
+			if dose < def.Doses && (!p.Illness || !strings.Contains(msg, fmt.Sprintf("%d/%d", dose, def.Doses))) {
+				t.Errorf("Expected pet to still be ill after dose %d, got %q", dose, msg)
+			}
+		}
+		if p.Illness || p.Disease != nil {
+			t.Error("Expected full course to cure the fever")
+		}
+	})
+
+	t.Run("Doses too close together are an overdose", func(t *testing.T) {
+		at(0)
+		p := Pet{Health: 40, Happiness: 50, Bond: MaxBond}
+		p.CatchDisease(DiseaseFever)
+		p.Checkup()
+		p.GiveMedicine()
+		healthAfterFirst := p.Health
+
+		at(time.Hour)
+		msg, _ := p.GiveMedicine()
+		if !strings.Contains(msg, "Overdose") {
+			t.Errorf("Expected overdose message, got %q", msg)
+		}
+		if p.Health != healthAfterFirst-OverdoseHealthPenalty || p.Disease.DosesGiven != 1 {
+			t.Errorf("Expected overdose penalty without progress: health %d, doses %d", p.Health, p.Disease.DosesGiven)
+		}
+	})
+
+	t.Run("Eating something weird upsets the stomach", func(t *testing.T) {
+		at(0)
+		p := Pet{Health: 80, CurrentEvent: &Event{Type: EventAteSomething, ExpiresAt: now.Add(-time.Minute)}}
+		originalRandFloat64 := RandFloat64
+		RandFloat64 = func() float64 { return 1.0 } // No new events
+		defer func() { RandFloat64 = originalRandFloat64 }()
+
+		TriggerRandomEvent(&p, QuietSchedule{})
+		if p.Disease == nil || p.Disease.Type != DiseaseUpsetStomach {
+			t.Errorf("Expected ignored weird snack to cause an upset stomach, got %+v", p.Disease)
+		}
+	})
+
+	t.Run("Found things can carry a cold", func(t *testing.T) {
+		at(0)
+		p := Pet{Health: 80, Sleeping: true, CurrentEvent: &Event{Type: EventFoundSomething, ExpiresAt: now.Add(-time.Minute)}}
+		originalRandFloat64 := RandFloat64
+		RandFloat64 = func() float64 { return 0.0 }
+		defer func() { RandFloat64 = originalRandFloat64 }()
+
+		TriggerRandomEvent(&p, QuietSchedule{})
+		if p.Disease == nil || p.Disease.Type != DiseaseCold {
+			t.Errorf("Expected ignored find to pass on a cold, got %+v", p.Disease)
+		}
+	})
+
+	t.Run("Older saves' illness becomes a fever", func(t *testing.T) {
+		at(0)
+		p := Pet{Health: 40, Illness: true, Bond: MaxBond}
+		msg, _ := p.Checkup()
+		if !strings.Contains(msg, "Fever") {
+			t.Errorf("Expected checkup to diagnose a fever, got %q", msg)
+		}
+	})
+}
+
+func TestVet(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNow(t)
+
+	at := func(d time.Duration) {
+		TimeNow = func() time.Time { return now.Add(d) }
+	}
+	t.Cleanup(func() { TimeNow = func() time.Time { return now } })
+
+	t.Run("Checkup diagnoses illness, even while incubating", func(t *testing.T) {
+		at(0)
+		p := Pet{Bond: InitialBond}
+		p.CatchDisease(DiseaseCold)
+		msg, ok := p.Checkup()
+		if !ok || !strings.Contains(msg, "Cold") {
+			t.Errorf("Expected checkup to find the cold, got %q", msg)
+		}
+		if !p.Disease.Diagnosed {
+			t.Error("Expected disease to be diagnosed")
+		}
+		if !strings.Contains(GetIllnessDisplay(p), "incubating") {
+			t.Errorf("Expected diagnosed incubating cold to show, got %q", GetIllnessDisplay(p))
+		}
+		if p.Bond != InitialBond+BondGainNormal {
+			t.Errorf("Expected a bond gain for catching it early, got %d", p.Bond)
+		}
+	})
+
+	t.Run("Healthy checkup", func(t *testing.T) {
+		at(0)
+		p := Pet{Bond: InitialBond}
+		msg, ok := p.Checkup()
+		if !ok || !strings.Contains(msg, "Clean bill of health") {
+			t.Errorf("Expected a clean bill of health, got %q", msg)
+		}
+		if p.Bond != InitialBond {
+			t.Errorf("Expected no bond from a healthy checkup, got %d", p.Bond)
+		}
+	})
+
+	t.Run("Checkups have a cooldown", func(t *testing.T) {
+		at(0)
+		p := Pet{}
+		p.Checkup()
+		if _, ok := p.Checkup(); ok {
+			t.Error("Expected a second checkup to be refused")
+		}
+		at(CheckupCooldown + time.Minute)
+		if _, ok := p.Checkup(); !ok {
+			t.Error("Expected a checkup after the cooldown")
+		}
+	})
+
+	t.Run("Medicine needs a diagnosis", func(t *testing.T) {
+		at(0)
+		p := Pet{Health: 40}
+		p.CatchDisease(DiseaseFever)
+		msg, ok := p.GiveMedicine()
+		if ok || !strings.Contains(msg, "checkup") {
+			t.Errorf("Expected medicine to wait for a diagnosis, got %q", msg)
+		}
+		if p.Health != 40 || p.Disease.DosesGiven != 0 {
+			t.Error("Expected no effect without a diagnosis")
+		}
+	})
+
+	t.Run("Medicine cures an unknown illness instead of crashing", func(t *testing.T) {
+		at(0)
+		p := Pet{Health: 40, Illness: true, Disease: &Disease{Type: "retired_disease", Diagnosed: true}}
+		msg, ok := p.GiveMedicine()
+		if !ok || !strings.Contains(msg, "Treatment complete") {
+			t.Errorf("Expected the unknown illness to be treated, got %q", msg)
+		}
+		if p.Disease != nil || p.Illness {
+			t.Error("Expected the unknown illness to be cured")
+		}
+	})
+
+	t.Run("Healthy pets refuse medicine, then overdose if forced", func(t *testing.T) {
+		at(0)
+		p := Pet{Health: 90, Happiness: 90, Bond: InitialBond}
+		msg, ok := p.GiveMedicine()
+		if ok || !strings.Contains(msg, "Spits out") {
+			t.Errorf("Expected healthy pet to refuse medicine, got %q", msg)
+		}
+		if p.Health != 90 || p.Bond != InitialBond {
+			t.Error("Expected a refused pill to have no effect")
+		}
+
+		at(time.Minute)
+		msg, _ = p.GiveMedicine()
+		if !strings.Contains(msg, "Overdose") {
+			t.Errorf("Expected forcing medicine to overdose, got %q", msg)
+		}
+		if p.Health != 90-OverdoseHealthPenalty || p.Happiness != 90-OverdoseHappinessPenalty {
+			t.Errorf("Expected overdose penalties, got health %d, happiness %d", p.Health, p.Happiness)
+		}
+		if p.Bond != InitialBond-MedicineAbuseBondLoss {
+			t.Errorf("Expected overdose to cost bond, got %d", p.Bond)
+		}
+	})
+
+	t.Run("Spamming medicine can't farm bond", func(t *testing.T) {
+		at(0)
+		p := Pet{Health: 50, Happiness: 90, Bond: InitialBond}
+		for i := 0; i < 10; i++ {
+			at(time.Duration(i) * time.Minute)
+			p.GiveMedicine()
+		}
+		if p.Bond >= InitialBond {
+			t.Errorf("Expected medicine spam to lose bond, got %d", p.Bond)
+		}
+		if p.Health >= 50 {
+			t.Errorf("Expected medicine spam to hurt health, got %d", p.Health)
+		}
+	})
+
+	t.Run("Spamming doses during treatment overdoses", func(t *testing.T) {
+		at(0)
+		p := Pet{Health: 40, Bond: InitialBond}
+		p.CatchDisease(DiseaseFever)
+		p.Checkup()
+		p.GiveMedicine()
+		bondAfterDose := p.Bond
+		for i := 1; i <= 3; i++ {
+			at(time.Duration(i) * time.Minute)
+			p.GiveMedicine()
+		}
+		if p.Disease.DosesGiven != 1 {
+			t.Errorf("Expected early doses not to count, got %d", p.Disease.DosesGiven)
+		}
+		if p.Bond != bondAfterDose-3*MedicineAbuseBondLoss {
+			t.Errorf("Expected each overdose to cost bond, got %d", p.Bond)
+		}
+	})
+
+	t.Run("Appropriate treatment builds bond", func(t *testing.T) {
+		at(0)
+		p := Pet{Health: 40, Bond: InitialBond}
+		p.CatchDisease(DiseaseFever)
+		p.Checkup()
+		bondBefore := p.Bond
+		p.GiveMedicine()
+		if p.Bond != bondBefore+BondGainWellTimed {
+			t.Errorf("Expected well-timed bond for treating symptoms, got %d", p.Bond)
+		}
+		if p.Health <= 40 {
+			t.Errorf("Expected medicine to restore health, got %d", p.Health)
+		}
+	})
+}
+
+func TestSchedule(t *testing.T) {
+	now := time.Date(2024, 1, 10, 12, 0, 0, 0, time.Local)
+
+	// visitsAt returns one interaction at the given local hour on each of the last n days
+	visitsAt := func(hour, n int) []Interaction {
+		var interactions []Interaction
+		for day := 1; day <= n; day++ {
+			visit := time.Date(2024, 1, 10-day, hour, 0, 0, 0, time.Local)
+			interactions = append(interactions, Interaction{Type: "feed", Time: visit})
+		}
+		return interactions
+	}
+
+	t.Run("Defaults to the chronotype preset", func(t *testing.T) {
+		p := Pet{Chronotype: ChronotypeEarlyBird}
+		wake, sleep := p.GetSchedule()
+		if wake != 5 || sleep != 21 {
+			t.Errorf("Expected early bird schedule 5-21, got %d-%d", wake, sleep)
+		}
+		if p.IsCustomSchedule() {
+			t.Error("Preset schedule should not be custom")
+		}
+		if !strings.Contains(GetScheduleDisplay(p), "Early Bird") {
+			t.Errorf("Expected preset display, got %q", GetScheduleDisplay(p))
+		}
+	})
+
+	t.Run("Drifts an hour a day toward late-night owner", func(t *testing.T) {
+		p := Pet{Chronotype: ChronotypeNormal, LastInteractions: visitsAt(1, 6)}
+		if !p.AdaptSchedule(now) {
+			t.Fatal("Expected schedule to adapt")
+		}
+		wake, sleep := p.GetSchedule()
+		if wake != 8 || sleep != 0 {
+			t.Errorf("Expected schedule to shift one hour later to 8-0, got %d-%d", wake, sleep)
+		}
+		if !p.IsCustomSchedule() || !strings.Contains(GetScheduleDisplay(p), "Custom (8:00-0:00)") {
+			t.Errorf("Expected custom display, got %q", GetScheduleDisplay(p))
+		}
+		if !IsActiveHours(&p, 23) || IsActiveHours(&p, 7) {
+			t.Error("Expected active hours to follow the learned schedule")
+		}
+
+		if p.AdaptSchedule(now.Add(time.Hour)) {
+			t.Error("Schedule should adapt at most once a day")
+		}
+		p.AdaptSchedule(now.Add(ScheduleAdaptInterval))
+		if wake, sleep := p.GetSchedule(); wake != 9 || sleep != 1 {
+			t.Errorf("Expected a second day's drift to 9-1, got %d-%d", wake, sleep)
+		}
+	})
+
+	t.Run("Drifts earlier for a morning owner", func(t *testing.T) {
+		p := Pet{Chronotype: ChronotypeNightOwl, LastInteractions: visitsAt(6, 6)}
+		p.AdaptSchedule(now)
+		if wake, sleep := p.GetSchedule(); wake != 9 || sleep != 1 {
+			t.Errorf("Expected night owl to shift earlier to 9-1, got %d-%d", wake, sleep)
+		}
+	})
+
+	t.Run("Settles once aligned with owner", func(t *testing.T) {
+		p := Pet{Chronotype: ChronotypeNormal, LastInteractions: visitsAt(15, 6)}
+		if p.AdaptSchedule(now) {
+			t.Error("Schedule centered on owner's activity should not move")
+		}
+	})
+
+	t.Run("Needs enough recent interactions", func(t *testing.T) {
+		p := Pet{Chronotype: ChronotypeNormal, LastInteractions: visitsAt(1, ScheduleMinInteractions-1)}
+		if p.AdaptSchedule(now) {
+			t.Error("Too few interactions should not move the schedule")
+		}
+
+		stale := Pet{Chronotype: ChronotypeNormal, LastInteractions: visitsAt(1, 6)}
+		if stale.AdaptSchedule(now.Add(ScheduleLearningWindow + 7*24*time.Hour)) {
+			t.Error("Interactions outside the learning window should be ignored")
+		}
+	})
+
+	t.Run("Heir inherits learned schedule with chronotype", func(t *testing.T) {
+		originalRandFloat64 := RandFloat64
+		RandFloat64 = func() float64 { return 0 }
+		defer func() { RandFloat64 = originalRandFloat64 }()
+
+		parent := Pet{Chronotype: ChronotypeNormal, Schedule: &Schedule{WakeHour: 9, SleepHour: 1}}
+		heir := NewHeir(parent)
+		if wake, sleep := heir.GetSchedule(); wake != 9 || sleep != 1 {
+			t.Errorf("Expected heir to inherit 9-1 schedule, got %d-%d", wake, sleep)
+		}
+		heir.Schedule.WakeHour = 10
+		if parent.Schedule.WakeHour != 9 {
+			t.Error("Heir's schedule should be a copy")
+		}
+	})
+}
+
+func TestTimeZone(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNowIn(t, time.UTC) // 12:00 UTC
+
+	t.Run("Active hours follow the pet's zone, not the machine's", func(t *testing.T) {
+		p := Pet{Chronotype: ChronotypeNormal, TimeZone: "Asia/Tokyo"} // 21:00 in Tokyo
+		if !p.IsActiveAt(now) {
+			t.Error("Expected 21:00 Tokyo to be within 7-23")
+		}
+		p.TimeZone = "America/Los_Angeles" // 04:00 in Los Angeles
+		if p.IsActiveAt(now) {
+			t.Error("Expected 04:00 Los Angeles to be outside 7-23")
+		}
+	})
+
+	t.Run("Unknown or empty zone falls back to local", func(t *testing.T) {
+		p := Pet{TimeZone: "Not/AZone"}
+		if p.Location() != time.Local {
+			t.Errorf("Expected fallback to local zone, got %v", p.Location())
+		}
+	})
+
+	t.Run("Changing zone grants a grace period", func(t *testing.T) {
+		p := Pet{Name: "Tester", Chronotype: ChronotypeNormal, TimeZone: "Asia/Tokyo"}
+		msg, err := p.SetTimeZone("America/Los_Angeles")
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !strings.Contains(msg, "America/Los_Angeles") {
+			t.Errorf("Expected message to name the new zone, got %q", msg)
+		}
+		if !p.IsActiveAt(now) {
+			t.Error("Expected pet to stay active while adjusting")
+		}
+		if p.IsActiveAt(now.Add(TimeZoneGracePeriod)) {
+			t.Error("Expected grace to end and 04:00 Los Angeles to be inactive")
+		}
+	})
+
+	t.Run("Changes are rate limited", func(t *testing.T) {
+		p := Pet{Name: "Tester", TimeZone: "Asia/Tokyo"}
+		if _, err := p.SetTimeZone("Europe/Berlin"); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if _, err := p.SetTimeZone("Asia/Tokyo"); err == nil {
+			t.Error("Expected a second change within the cooldown to be refused")
+		}
+		if p.TimeZone != "Europe/Berlin" {
+			t.Errorf("Expected zone to stay Europe/Berlin, got %s", p.TimeZone)
+		}
+
+		TimeNow = func() time.Time { return now.Add(TimeZoneChangeCooldown) }
+		defer func() { TimeNow = func() time.Time { return now } }()
+		if _, err := p.SetTimeZone("Asia/Tokyo"); err != nil {
+			t.Errorf("Expected change after cooldown to succeed: %v", err)
+		}
+	})
+
+	t.Run("Rejects unknown and unchanged zones", func(t *testing.T) {
+		p := Pet{Name: "Tester", TimeZone: "Asia/Tokyo"}
+		if _, err := p.SetTimeZone("Not/AZone"); err == nil {
+			t.Error("Expected unknown zone to be rejected")
+		}
+		if _, err := p.SetTimeZone("Asia/Tokyo"); err == nil {
+			t.Error("Expected same zone to be rejected")
+		}
+		if p.TimeZoneChangedAt != nil {
+			t.Error("Rejected changes should not start the cooldown")
+		}
+	})
+
+	t.Run("Schedule learning reads hours in the pet's zone", func(t *testing.T) {
+		tokyo, _ := time.LoadLocation("Asia/Tokyo")
+		var visits []Interaction
+		for day := 1; day <= 6; day++ {
+			visits = append(visits, Interaction{Type: "feed", Time: time.Date(2023, 12, 31-day, 1, 0, 0, 0, tokyo)})
+		}
+		p := Pet{Chronotype: ChronotypeNormal, TimeZone: "Asia/Tokyo", LastInteractions: visits}
+		p.AdaptSchedule(now)
+		if wake, sleep := p.GetSchedule(); wake != 8 || sleep != 0 {
+			t.Errorf("Expected 1am Tokyo visits to shift schedule later to 8-0, got %d-%d", wake, sleep)
+		}
+	})
+}
+
+func TestVacation(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNow(t)
+
+	saveAt := func(p Pet, lastSaved time.Time) {
+		p.LastSaved = lastSaved
+		data, _ := json.MarshalIndent(p, "", "  ")
+		os.WriteFile(TestConfigPath, data, 0644)
+	}
+	week := 7 * 24 * time.Hour
+
+	t.Run("Start enforces limits", func(t *testing.T) {
+		p := Pet{Name: "Tester", Hunger: 80}
+		if _, err := p.StartVacation(now.Add(-time.Hour)); err == nil {
+			t.Error("Expected a past return date to be rejected")
+		}
+		if _, err := p.StartVacation(now.Add(MaxVacationDuration + time.Hour)); err == nil {
+			t.Error("Expected a trip longer than the maximum to be rejected")
+		}
+
+		critical := Pet{Name: "Tester", CriticalStartTime: &now}
+		if _, err := critical.StartVacation(now.Add(week)); err == nil {
+			t.Error("Expected a pet in critical condition to be refused")
+		}
+
+		recent := now.Add(-24 * time.Hour)
+		rested := Pet{Name: "Tester", LastVacationEnd: &recent}
+		if _, err := rested.StartVacation(now.Add(week)); err == nil {
+			t.Error("Expected vacations to need a cooldown in between")
+		}
+
+		msg, err := p.StartVacation(now.Add(week))
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !strings.Contains(msg, "sitter") || p.Vacation == nil || p.Vacation.Before.Hunger != 80 {
+			t.Errorf("Expected vacation to start with a snapshot, got %q", msg)
+		}
+		if _, err := p.StartVacation(now.Add(week)); err == nil {
+			t.Error("Expected a second vacation to be refused while away")
+		}
+	})
+
+	t.Run("A week away doesn't kill, age or unbond the pet", func(t *testing.T) {
+		left := now.Add(-week)
+		p := NewPet(&TestConfig{InitialHunger: 80, InitialHappiness: 80, InitialEnergy: 80, Health: 80, LastSavedTime: left.Add(-week)})
+		p.Bond = 70
+		p.LastInteractions = []Interaction{{Type: "feed", Time: left}}
+		p.Vacation = &Vacation{Start: left, Until: now.Add(time.Hour), Bond: p.Bond}
+		saveAt(p, left)
+
+		loaded := LoadState()
+		if loaded.Dead {
+			t.Fatalf("Expected pet to survive with the sitter, died of %s", loaded.CauseOfDeath)
+		}
+		if loaded.Hunger < VacationStatFloor || loaded.Health < VacationStatFloor {
+			t.Errorf("Expected sitter to keep stats at %d+, got hunger %d health %d", VacationStatFloor, loaded.Hunger, loaded.Health)
+		}
+		if loaded.Age != 168 {
+			t.Errorf("Expected age to stay at 168h while away, got %d", loaded.Age)
+		}
+		if loaded.Bond != 70 {
+			t.Errorf("Expected bond to hold at 70, got %d", loaded.Bond)
+		}
+		if GetStatus(loaded) != StatusEmojiVacation {
+			t.Errorf("Expected vacation status, got %q", GetStatus(loaded))
+		}
+	})
+
+	t.Run("Decay is slowed while away", func(t *testing.T) {
+		left := now.Add(-8 * time.Hour)
+		p := NewPet(&TestConfig{InitialHunger: 100, InitialHappiness: 100, InitialEnergy: 100, Health: 100, LastSavedTime: left})
+		p.Traits = nil
+		p.Vacation = &Vacation{Start: left, Until: now.Add(week)}
+		saveAt(p, left)
+
+		loaded := LoadState()
+		expected := MaxStat - int(8*VacationDecayMult*HungerDecreaseRate)
+		if loaded.Hunger != expected {
+			t.Errorf("Expected hunger %d after 8 slowed hours, got %d", expected, loaded.Hunger)
+		}
+	})
+
+	t.Run("Vacation ends on its own with a summary", func(t *testing.T) {
+		left := now.Add(-3 * 24 * time.Hour)
+		p := NewPet(&TestConfig{InitialHunger: 90, InitialHappiness: 90, InitialEnergy: 90, Health: 90, LastSavedTime: left})
+		p.Vacation = &Vacation{Start: left, Until: now.Add(-time.Hour), Before: StatCheck{Hunger: 90}}
+		saveAt(p, left)
+
+		loaded := LoadState()
+		if loaded.Vacation != nil {
+			t.Fatal("Expected vacation to end after the return date")
+		}
+		if loaded.VacationHours != 71 {
+			t.Errorf("Expected 71 paused hours banked, got %.1f", loaded.VacationHours)
+		}
+		if loaded.Age != 1 {
+			t.Errorf("Expected only the hour since returning to count toward age, got %d", loaded.Age)
+		}
+		if loaded.LastVacationEnd == nil {
+			t.Error("Expected the end to start the cooldown")
+		}
+		summary := loaded.TakeVacationSummary()
+		if !strings.Contains(summary, "Welcome back") || !strings.Contains(summary, "Hunger 90→") {
+			t.Errorf("Expected a return summary, got %q", summary)
+		}
+		if loaded.VacationSummary != "" {
+			t.Error("Expected the summary to be cleared once taken")
+		}
+	})
+
+	t.Run("Sitter care covers a trip that ended before the next load", func(t *testing.T) {
+		left := now.Add(-week - 9*time.Hour)
+		p := NewPet(&TestConfig{InitialHunger: 80, InitialHappiness: 80, InitialEnergy: 80, Health: 80, LastSavedTime: left})
+		p.Vacation = &Vacation{Start: left, Until: now.Add(-9 * time.Hour)}
+		saveAt(p, left)
+
+		loaded := LoadState()
+		if loaded.Dead {
+			t.Fatalf("Expected the pet to survive the trip, died of %s", loaded.CauseOfDeath)
+		}
+		for name, value := range map[string]int{"happiness": loaded.Happiness, "energy": loaded.Energy,
+			"health": loaded.Health} {
+			if value <= MinStat {
+				t.Errorf("Expected %s to start from the sitter's floor, got %d", name, value)
+			}
+		}
+		if loaded.Health <= 20 {
+			t.Errorf("Expected health to stay out of danger, got %d", loaded.Health)
+		}
+		if loaded.Hunger >= VacationStatFloor {
+			t.Errorf("Expected the hours since returning to decay at the full rate, got hunger %d", loaded.Hunger)
+		}
+	})
+
+	t.Run("A finished trip doesn't count as neglect on later loads", func(t *testing.T) {
+		left := now.Add(-13 * 24 * time.Hour)
+		p := NewPet(&TestConfig{InitialHunger: 80, InitialHappiness: 80, InitialEnergy: 80, Health: 80, LastSavedTime: left})
+		p.Bond = 70
+		p.LastInteractions = []Interaction{{Type: "feed", Time: left}}
+		p.Vacation = &Vacation{Start: left, Until: now, Bond: p.Bond}
+		saveAt(p, left)
+
+		returned := LoadState()
+		if returned.Vacation != nil || len(returned.PastVacations) != 1 {
+			t.Fatalf("Expected the trip to end and be remembered, got %+v", returned.PastVacations)
+		}
+		SaveState(&returned)
+
+		TimeNow = func() time.Time { return now.Add(3 * time.Hour) }
+		defer func() { TimeNow = func() time.Time { return now } }()
+		later := LoadState()
+		if later.Bond != 70 {
+			t.Errorf("Expected bond to hold at 70 hours after the return, got %d", later.Bond)
+		}
+	})
+
+	t.Run("Return dates cover the whole day", func(t *testing.T) {
+		until, err := ParseReturnDate("2024-06-01", time.UTC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !until.Equal(time.Date(2024, 6, 2, 0, 0, 0, 0, time.UTC)) {
+			t.Errorf("Expected the trip to last through June 1, got %s", until)
+		}
+		if _, err := ParseReturnDate("June 1", time.UTC); err == nil {
+			t.Error("Expected a malformed date to be rejected")
+		}
+	})
+
+	t.Run("Paused time delays evolution", func(t *testing.T) {
+		p := Pet{Logs: []LogEntry{{Time: now.Add(-10 * time.Hour)}}, VacationHours: 5}
+		if p.AgeAt(now) != 5 {
+			t.Errorf("Expected age 5 after 5 paused hours, got %d", p.AgeAt(now))
+		}
+		forecast := ForecastEvolution(p, now)
+		expected := time.Duration(ChildStageAge-5) * time.Hour
+		if forecast.TimeUntil != expected {
+			t.Errorf("Expected evolution in %s, got %s", expected, forecast.TimeUntil)
+		}
+	})
+}
+
+func TestQuietHours(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNowIn(t, time.UTC) // Monday 12:00 UTC
+
+	saveAt := func(p Pet, lastSaved time.Time) {
+		p.LastSaved = lastSaved
+		data, _ := json.MarshalIndent(p, "", "  ")
+		os.WriteFile(TestConfigPath, data, 0644)
+	}
+	workdays, _ := NewQuietBlock("09:00-17:00", "mon,tue,wed,thu,fri")
+	// Deferred so it runs before cleanup resets the config path, never touching the real quiet.json
+	defer SaveQuietHours(QuietHours{})
+
+	t.Run("Blocks validate their input", func(t *testing.T) {
+		if _, err := NewQuietBlock("9-5", ""); err == nil {
+			t.Error("Expected malformed window to be rejected")
+		}
+		if _, err := NewQuietBlock("09:00-17:00", "funday"); err == nil {
+			t.Error("Expected unknown day to be rejected")
+		}
+		if _, err := NewQuietBlock("06:00-22:00", ""); err == nil {
+			t.Error("Expected a block over the daily allowance to be rejected")
+		}
+	})
+
+	t.Run("Blocks apply on their days and wrap midnight", func(t *testing.T) {
+		night, _ := NewQuietBlock("22:00-06:00", "")
+		q := QuietHours{Blocks: []QuietBlock{workdays, night}}
+		if !q.IsQuiet(now, time.UTC) {
+			t.Error("Expected Monday noon to be quiet")
+		}
+		if q.IsQuiet(now.AddDate(0, 0, 5), time.UTC) {
+			t.Error("Expected Saturday noon not to be quiet")
+		}
+		if !q.IsQuiet(now.Add(14*time.Hour), time.UTC) {
+			t.Error("Expected 02:00 to be quiet under the overnight block")
+		}
+		if q.IsQuiet(now.Add(7*time.Hour), time.UTC) {
+			t.Error("Expected 19:00 not to be quiet")
+		}
+	})
+
+	t.Run("Quiet time is capped per day", func(t *testing.T) {
+		morning, _ := NewQuietBlock("00:00-08:00", "")
+		afternoon, _ := NewQuietBlock("10:00-18:00", "")
+		q := QuietHours{Blocks: []QuietBlock{morning, afternoon}}
+		day := now.Add(-12 * time.Hour)
+		if hours := q.OverlapHours(day, day.Add(24*time.Hour), time.UTC); hours != MaxQuietHoursPerDay {
+			t.Errorf("Expected %d quiet hours, got %.1f", MaxQuietHoursPerDay, hours)
+		}
+	})
+
+	t.Run("Do not disturb", func(t *testing.T) {
+		q := QuietHours{DND: &TimeRange{Start: now, End: now.Add(90 * time.Minute)}}
+		if !q.IsQuiet(now.Add(time.Hour), time.UTC) || q.IsQuiet(now.Add(2*time.Hour), time.UTC) {
+			t.Error("Expected do not disturb to last 90 minutes")
+		}
+	})
+
+	t.Run("Calendar events count as busy", func(t *testing.T) {
+		ics := strings.Join([]string{
+			"BEGIN:VCALENDAR",
+			"BEGIN:VEVENT",
+			"SUMMARY:Standup",
+			"DTSTART:20240101T140000Z",
+			"DTEND:20240101T143000Z",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"SUMMARY:Planning with a very long title that the exporter",
+			"  folded onto a second line",
+			"DTSTART;TZID=Europe/Berlin:20240101T170000",
+			"DTEND;TZID=Europe/Berlin:20240101T180000",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"SUMMARY:Holiday",
+			"DTSTART;VALUE=DATE:20240102",
+			"DTEND;VALUE=DATE:20240103",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"SUMMARY:Focus (free)",
+			"DTSTART:20240101T150000Z",
+			"DTEND:20240101T160000Z",
+			"TRANSP:TRANSPARENT",
+			"END:VEVENT",
+			"BEGIN:VEVENT",
+			"SUMMARY:Cancelled",
+			"DTSTART:20240101T180000Z",
+			"DTEND:20240101T190000Z",
+			"STATUS:CANCELLED",
+			"END:VEVENT",
+			"END:VCALENDAR",
+		}, "\r\n")
+		path := filepath.Join(t.TempDir(), "work.ics")
+		os.WriteFile(path, []byte(ics), 0644)
+
+		busy, err := LoadCalendarBusy(path, time.UTC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(busy) != 2 {
+			t.Fatalf("Expected 2 busy blocks, got %d: %v", len(busy), busy)
+		}
+		if !busy[1].Start.Equal(time.Date(2024, 1, 1, 16, 0, 0, 0, time.UTC)) {
+			t.Errorf("Expected TZID to be honored, got %v", busy[1].Start)
+		}
+
+		q := QuietHours{Calendar: path}
+		if !q.IsQuiet(now.Add(2*time.Hour+10*time.Minute), time.UTC) {
+			t.Error("Expected the standup to be quiet")
+		}
+		if q.IsQuiet(now.Add(3*time.Hour+10*time.Minute), time.UTC) {
+			t.Error("Expected the free event not to be quiet")
+		}
+	})
+
+	t.Run("The calendar is read once per load", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "work.ics")
+		os.WriteFile(path, []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n"), 0644)
+		SaveQuietHours(QuietHours{Blocks: []QuietBlock{workdays}, Calendar: path})
+		defer SaveQuietHours(QuietHours{})
+
+		reads := 0
+		originalLoadCalendar := loadCalendar
+		loadCalendar = func(path string, loc *time.Location) ([]TimeRange, error) {
+			reads++
+			return originalLoadCalendar(path, loc)
+		}
+		defer func() { loadCalendar = originalLoadCalendar }()
+
+		lastSaved := now.Add(-3 * time.Hour)
+		p := NewPet(&TestConfig{InitialHunger: 10, InitialHappiness: 10, InitialEnergy: 10, Health: 10, LastSavedTime: lastSaved})
+		p.TimeZone = "UTC"
+		p.CriticalStartTime = &lastSaved
+		p.LastInteractions = []Interaction{{Type: "feed", Time: now.Add(-48 * time.Hour)}}
+		p.PastVacations = []VacationSpan{{Start: now.Add(-10 * 24 * time.Hour), End: now.Add(-5 * 24 * time.Hour)}}
+		p.CatchDisease(DiseaseCold)
+		saveAt(p, lastSaved)
+
+		LoadState()
+		if reads != 1 {
+			t.Errorf("Expected the calendar to be read once, got %d reads", reads)
+		}
+	})
+
+	t.Run("Quiet hours pause decay and neglect", func(t *testing.T) {
+		SaveQuietHours(QuietHours{Blocks: []QuietBlock{workdays}})
+		defer SaveQuietHours(QuietHours{})
+
+		lastSaved := now.Add(-3 * time.Hour) // 09:00, start of the work block
+		p := NewPet(&TestConfig{InitialHunger: 80, InitialHappiness: 80, InitialEnergy: 80, Health: 80, LastSavedTime: lastSaved})
+		p.TimeZone = "UTC"
+		p.Bond = 60
+		p.LastInteractions = []Interaction{{Type: "feed", Time: now.Add(-38 * time.Hour)}}
+		saveAt(p, lastSaved)
+
+		loaded := LoadState()
+		if loaded.Hunger != 80 {
+			t.Errorf("Expected no hunger decay during quiet hours, got %d", loaded.Hunger)
+		}
+		// 38 hours since the last feed, but 3 of them were Monday's quiet morning, leaving 35: under
+		// the 36 hours it takes to lose a point of bond
+		if loaded.Bond != 60 {
+			t.Errorf("Expected quiet hours not to count as neglect, got bond %d", loaded.Bond)
+		}
+	})
+
+	t.Run("Quiet hours pause illness symptoms", func(t *testing.T) {
+		SaveQuietHours(QuietHours{Blocks: []QuietBlock{workdays}})
+		defer SaveQuietHours(QuietHours{})
+
+		p := Pet{Energy: 80, Happiness: 80, TimeZone: "UTC", Illness: true}
+		p.Disease = &Disease{Type: DiseaseCold, SymptomsAt: now.Add(-5 * time.Hour), RecoversAt: now.Add(24 * time.Hour)}
+		p.UpdateDisease(p.LoadQuietSchedule(), now.Add(-5*time.Hour), now)
+		// Only the two hours before the 09:00 work block count: -2 energy/hr, -1 happiness/hr
+		if p.Energy != 76 || p.Happiness != 78 {
+			t.Errorf("Expected symptoms to pause in quiet hours, got energy %d happiness %d", p.Energy, p.Happiness)
+		}
+	})
+
+	t.Run("No events during quiet hours", func(t *testing.T) {
+		SaveQuietHours(QuietHours{Blocks: []QuietBlock{workdays}})
+		defer SaveQuietHours(QuietHours{})
+
+		originalRandFloat64 := RandFloat64
+		RandFloat64 = func() float64 { return 0 }
+		defer func() { RandFloat64 = originalRandFloat64 }()
+
+		p := Pet{Hunger: 80, Happiness: 80, Energy: 80, Health: 80, Cleanliness: 80, TimeZone: "UTC"}
+		p.CurrentEvent = &Event{Type: EventAteSomething, StartTime: now.Add(-time.Hour), ExpiresAt: now.Add(-time.Minute)}
+		TriggerRandomEvent(&p, p.LoadQuietSchedule())
+		if p.CurrentEvent != nil {
+			t.Errorf("Expected no new event during quiet hours, got %s", p.CurrentEvent.Type)
+		}
+		if p.Disease != nil || len(p.EventLog) != 0 {
+			t.Error("Expected an event expiring in quiet hours to pass without consequences")
+		}
+	})
+}
+
+func TestAchievements(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNow(t)
+
+	t.Run("Milestones unlock once and persist across pets", func(t *testing.T) {
+		p := Pet{Name: "First", Bond: SoulmatesBond, EvolutionHistory: []EvolutionRecord{{From: FormBaby, To: FormHealthyChild}}}
+		earned := CheckAchievements(&p)
+		if len(earned) != 2 {
+			t.Fatalf("Expected First Steps and Soulmates, got %v", earned)
+		}
+
+		heir := Pet{Name: "Second", Bond: SoulmatesBond}
+		if again := CheckAchievements(&heir); len(again) != 0 {
+			t.Errorf("Expected achievements to stay unlocked across pets, got %v", again)
+		}
+		a := LoadAchievements()
+		if !a.Has(AchievementSoulmates) || a.Unlocked[0].PetName != "First" {
+			t.Errorf("Expected unlocks to be saved with the pet that earned them, got %+v", a.Unlocked)
+		}
+	})
+
+	t.Run("Milestones are checked when saving, not on read-only loads", func(t *testing.T) {
+		os.Remove(GetAchievementsPath())
+		p := NewPet(&TestConfig{InitialHunger: 80, InitialHappiness: 80, InitialEnergy: 80, Health: 80, LastSavedTime: now})
+		p.Bond = SoulmatesBond
+		data, _ := json.Marshal(p)
+		os.WriteFile(TestConfigPath, data, 0644)
+
+		loaded := LoadState()
+		if _, err := os.Stat(GetAchievementsPath()); !os.IsNotExist(err) {
+			t.Error("Expected a read-only load not to write achievements")
+		}
+		SaveState(&loaded)
+		if !LoadAchievements().Has(AchievementSoulmates) {
+			t.Error("Expected saving to unlock Soulmates")
+		}
+	})
+
+	t.Run("Each milestone checks its condition", func(t *testing.T) {
+		cases := []struct {
+			id  string
+			pet Pet
+		}{
+			{AchievementEliteAdult, Pet{Form: FormEliteAdult}},
+			{AchievementWeekSurvivor, Pet{Age: WeekSurvivorAge}},
+			{AchievementAttentive, Pet{EventsResponded: AttentiveEventCount}},
+			{AchievementPerfectDay, Pet{Age: 30, EventLog: []EventLogEntry{
+				{Type: EventChasing, Time: now.Add(-2 * time.Hour)},
+				{Type: EventSinging, Time: now.Add(-10 * time.Hour)},
+				{Type: EventSinging, Time: now.Add(-30 * time.Hour), WasIgnored: true},
+			}}},
+		}
+		for _, c := range cases {
+			def := GetAchievementDefinition(c.id)
+			if !def.Check(&c.pet, Achievements{}) {
+				t.Errorf("Expected %s to unlock", def.Name)
+			}
+			if def.Check(&Pet{}, Achievements{}) {
+				t.Errorf("Expected %s to stay locked for a new pet", def.Name)
+			}
+		}
+
+		ignored := Pet{Age: 30, EventLog: []EventLogEntry{
+			{Type: EventChasing, Time: now.Add(-2 * time.Hour)},
+			{Type: EventSinging, Time: now.Add(-3 * time.Hour)},
+			{Type: EventSinging, Time: now.Add(-5 * time.Hour), WasIgnored: true},
+		}}
+		if ignored.HadPerfectDay(now) {
+			t.Error("Expected an ignored event to spoil the day")
+		}
+	})
+
+	t.Run("Responding counts past the event log cap", func(t *testing.T) {
+		p := Pet{Hunger: 80, Happiness: 80, Energy: 80, Health: 80}
+		for i := 0; i < 25; i++ {
+			p.CurrentEvent = &Event{Type: EventSinging, StartTime: now, ExpiresAt: now.Add(time.Hour)}
+			p.RespondToEvent()
+		}
+		if p.EventsResponded != 25 || len(p.EventLog) != 20 {
+			t.Errorf("Expected 25 responses with a capped log, got %d and %d", p.EventsResponded, len(p.EventLog))
+		}
+	})
+
+	t.Run("Chase catches accumulate", func(t *testing.T) {
+		p := Pet{Name: "Hunter"}
+		var earned []AchievementDefinition
+		for i := 0; i < MasterHunterCatches; i++ {
+			earned = append(earned, RecordChaseCatch(&p)...)
+		}
+		if len(earned) != 2 || earned[0].ID != AchievementFirstCatch || earned[1].ID != AchievementMasterHunter {
+			t.Errorf("Expected first catch then master hunter, got %v", earned)
+		}
+		if LoadAchievements().ChaseCatches != MasterHunterCatches {
+			t.Errorf("Expected %d catches saved", MasterHunterCatches)
+		}
+	})
+
+	t.Run("Unannounced achievements are taken once", func(t *testing.T) {
+		pending := TakeUnannouncedAchievements()
+		if len(pending) == 0 {
+			t.Fatal("Expected achievements waiting to be announced")
+		}
+		if again := TakeUnannouncedAchievements(); len(again) != 0 {
+			t.Errorf("Expected announcements to be marked shown, got %v", again)
+		}
+	})
+}
+
+func TestDailyQuests(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNowIn(t, time.UTC) // 2024-01-01 12:00 UTC, matching the pets' zone
+	today := "2024-01-01"
+
+	newPet := func(quests ...string) Pet {
+		p := Pet{Name: "Tester", Bond: InitialBond, Chronotype: ChronotypeNormal, TimeZone: "UTC",
+			Inventory: map[string]int{}, Hunger: 80, Happiness: 80, Energy: 80, Health: 80, Cleanliness: 80}
+		p.Quests = &DailyQuests{Day: today}
+		for _, quest := range quests {
+			p.Quests.Quests = append(p.Quests.Quests, Quest{Type: quest})
+		}
+		return p
+	}
+
+	t.Run("Quests are dealt from the pet's state", func(t *testing.T) {
+		for i := 0; i < 20; i++ {
+			p := Pet{TimeZone: "UTC", Cleanliness: MaxStat, LifeStage: StageBaby}
+			p.UpdateQuests(now)
+			if p.Quests == nil || p.Quests.Day != today || len(p.Quests.Quests) != DailyQuestCount {
+				t.Fatalf("Expected %d quests for today, got %+v", DailyQuestCount, p.Quests)
+			}
+			for _, quest := range p.Quests.Quests {
+				if quest.Type == QuestBath || quest.Type == QuestCheckup || quest.Type == QuestPractice {
+					t.Errorf("Did not expect %s for a clean, healthy baby", quest.Type)
+				}
+			}
+		}
+
+		originalRandFloat64 := RandFloat64
+		RandFloat64 = func() float64 { return 0.99 }
+		defer func() { RandFloat64 = originalRandFloat64 }()
+		offersCheckup := func(p Pet) bool {
+			p.UpdateQuests(now)
+			for _, quest := range p.Quests.Quests {
+				if quest.Type == QuestCheckup {
+					return true
+				}
+			}
+			return false
+		}
+		incubating := Pet{TimeZone: "UTC", Cleanliness: MaxStat}
+		incubating.CatchDisease(DiseaseCold)
+		if offersCheckup(incubating) {
+			t.Error("Expected an undiagnosed, incubating illness not to be given away by a checkup quest")
+		}
+		diagnosed := incubating
+		diagnosed.Disease = &Disease{Type: DiseaseCold, Diagnosed: true}
+		if !offersCheckup(diagnosed) {
+			t.Error("Expected a diagnosed illness to be offered a checkup quest")
+		}
+		sick := Pet{TimeZone: "UTC", Cleanliness: MaxStat, Illness: true}
+		sick.CatchDisease(DiseaseFever)
+		if !offersCheckup(sick) {
+			t.Error("Expected a pet showing symptoms to be offered a checkup quest")
+		}
+	})
+
+	t.Run("Action quests complete from interactions and pay out", func(t *testing.T) {
+		p := newPet(QuestVariedDiet, QuestPlayActive)
+		p.LastInteractions = []Interaction{
+			{Type: "feed", Food: FoodKibble, Time: now.Add(-3 * time.Hour)},
+			{Type: "feed", Food: FoodFish, Time: now.Add(-time.Hour)},
+			{Type: "feed", Food: FoodTreat, Time: now.Add(-24 * time.Hour)}, // yesterday
+		}
+		messages := p.UpdateQuests(now)
+		if !p.Quests.Quests[0].Done || p.Quests.Quests[1].Done {
+			t.Fatalf("Expected only the varied diet quest done, got %+v", p.Quests.Quests)
+		}
+		if len(messages) != 1 || !strings.Contains(messages[0], "Feed 2 different foods") {
+			t.Errorf("Expected a completion message, got %v", messages)
+		}
+		if p.Bond != InitialBond+1 || p.Inventory[FoodVegetables] != 2 {
+			t.Errorf("Expected +1 bond and 2 vegetables, got bond %d and %d vegetables", p.Bond, p.Inventory[FoodVegetables])
+		}
+
+		p.UpdateQuests(now)
+		if p.Bond != InitialBond+1 {
+			t.Error("Expected a quest to pay out only once")
+		}
+	})
+
+	t.Run("Play only counts during active hours", func(t *testing.T) {
+		p := newPet(QuestPlayActive)
+		p.LastInteractions = []Interaction{{Type: "play", Time: now.Add(-9 * time.Hour)}} // 03:00
+		p.UpdateQuests(now)
+		if p.Quests.Quests[0].Done {
+			t.Error("Expected 3am play not to count")
+		}
+		p.LastInteractions = append(p.LastInteractions, Interaction{Type: "play", Time: now.Add(-time.Hour)})
+		p.UpdateQuests(now)
+		if !p.Quests.Quests[0].Done {
+			t.Error("Expected 11am play to count")
+		}
+	})
+
+	t.Run("All-day quests are judged when the day ends", func(t *testing.T) {
+		p := newPet(QuestHappyAllDay)
+		p.StatCheckpoints = map[string][]StatCheck{"stage_0": {}}
+		for hour := 0; hour < 24; hour += 4 {
+			check := StatCheck{Time: now.Add(time.Duration(hour-12) * time.Hour), Happiness: 75}
+			p.StatCheckpoints["stage_0"] = append(p.StatCheckpoints["stage_0"], check)
+		}
+		p.UpdateQuests(now)
+		if p.Quests.Quests[0].Done {
+			t.Fatal("Expected the all-day quest to wait for the day to end")
+		}
+
+		messages := p.UpdateQuests(now.Add(13 * time.Hour))
+		if p.Quests.Day != "2024-01-02" {
+			t.Errorf("Expected new quests for the next day, got %s", p.Quests.Day)
+		}
+		if p.LastQuestDay != today || p.QuestStreak != 1 || p.Inventory[FoodTreat] != 1 {
+			t.Errorf("Expected yesterday to count toward the streak with a treat, got %+v", p)
+		}
+		if len(messages) < 2 {
+			t.Errorf("Expected quest and streak messages, got %v", messages)
+		}
+
+		unhappy := newPet(QuestHappyAllDay)
+		unhappy.StatCheckpoints = map[string][]StatCheck{"stage_0": {
+			{Time: now.Add(-8 * time.Hour), Happiness: 90}, {Time: now.Add(-4 * time.Hour), Happiness: 40},
+			{Time: now, Happiness: 90}, {Time: now.Add(4 * time.Hour), Happiness: 90},
+		}}
+		unhappy.UpdateQuests(now.Add(13 * time.Hour))
+		if unhappy.QuestStreak != 0 {
+			t.Error("Expected a dip below 60 to fail the all-day quest")
+		}
+	})
+
+	t.Run("Streaks build on consecutive days and lapse", func(t *testing.T) {
+		p := newPet(QuestBath)
+		p.LastQuestDay = "2023-12-31"
+		p.QuestStreak = QuestStreakBonusDays - 1
+		p.LastInteractions = []Interaction{{Type: "clean", Time: now.Add(-time.Hour)}}
+		messages := p.UpdateQuests(now)
+		if p.QuestStreak != QuestStreakBonusDays || p.BestQuestStreak != QuestStreakBonusDays {
+			t.Errorf("Expected streak %d, got %d", QuestStreakBonusDays, p.QuestStreak)
+		}
+		if p.Bond != InitialBond+1+QuestStreakBonusBond {
+			t.Errorf("Expected quest and streak bonus bond, got %d", p.Bond)
+		}
+		if !strings.Contains(strings.Join(messages, " "), "bonus") {
+			t.Errorf("Expected the streak bonus to be announced, got %v", messages)
+		}
+
+		if p.CurrentQuestStreak(now.Add(24*time.Hour)) != QuestStreakBonusDays {
+			t.Error("Expected the streak to hold through the next day")
+		}
+		if p.CurrentQuestStreak(now.Add(48*time.Hour)) != 0 {
+			t.Error("Expected the streak to lapse after a missed day")
+		}
+
+		lapsed := newPet(QuestBath)
+		lapsed.LastQuestDay = "2023-12-25"
+		lapsed.QuestStreak = 5
+		lapsed.LastInteractions = p.LastInteractions
+		lapsed.UpdateQuests(now)
+		if lapsed.QuestStreak != 1 {
+			t.Errorf("Expected a lapsed streak to restart at 1, got %d", lapsed.QuestStreak)
+		}
+	})
+}
+
+func TestCoinEconomy(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+	now := mockTimeNow(t)
+	economy := DefaultTuning().Economy
+
+	t.Run("Responding to an event earns coins once the pet is saved", func(t *testing.T) {
+		defer os.Remove(GetWalletPath())
+		p := NewPet(nil)
+		p.CurrentEvent = &Event{Type: EventChasing, StartTime: now, ExpiresAt: now.Add(10 * time.Minute)}
+		p.RespondToEvent()
+		if len(p.PendingCoins) != 1 || p.PendingCoins[0].Amount != economy.EventReward {
+			t.Fatalf("Expected %d coins pending, got %+v", economy.EventReward, p.PendingCoins)
+		}
+		if LoadWallet().Balance != 0 {
+			t.Error("Expected coins to wait in the pet until it is saved")
+		}
+
+		SaveState(&p)
+		w := LoadWallet()
+		if w.Balance != economy.EventReward || len(w.Transactions) != 1 || w.Transactions[0].Balance != economy.EventReward {
+			t.Errorf("Expected the reward to be banked and logged, got %+v", w)
+		}
+		if len(p.PendingCoins) != 0 {
+			t.Error("Expected pending coins to be cleared once banked")
+		}
+	})
+
+	t.Run("Tuning file overrides rewards and prices", func(t *testing.T) {
+		defer os.Remove(GetTuningPath())
+		tuning := `{"economy": {"event_reward": 7, "prices": {"crown": 3}}}`
+		if err := os.WriteFile(GetTuningPath(), []byte(tuning), 0644); err != nil {
+			t.Fatal(err)
+		}
+		got := GetTuning().Economy
+		if got.EventReward != 7 || got.Prices[ShopCrown] != 3 {
+			t.Errorf("Expected tuning overrides to apply, got %+v", got)
+		}
+		if got.QuestReward != economy.QuestReward {
+			t.Errorf("Expected unset values to keep their defaults, got quest reward %d", got.QuestReward)
+		}
+	})
+
+	t.Run("Buying spends coins and refuses what can't be afforded", func(t *testing.T) {
+		defer os.Remove(GetWalletPath())
+		SaveWallet(Wallet{Balance: economy.Prices[ShopVitamins]})
+		p := Pet{Name: "Tester", Health: 50}
+
+		if msg, ok := p.Buy(ShopVitamins); !ok || p.Health != 50+economy.VitaminsHealth {
+			t.Fatalf("Expected vitamins to heal, got %q and health %d", msg, p.Health)
+		}
+		w := LoadWallet()
+		if w.Balance != 0 || w.Transactions[0].Amount != -economy.Prices[ShopVitamins] {
+			t.Errorf("Expected the purchase to be logged, got %+v", w)
+		}
+		if msg, ok := p.Buy(ShopVitamins); ok || !strings.Contains(msg, "Not enough coins") {
+			t.Errorf("Expected a broke owner to be refused, got %q", msg)
+		}
+	})
+
+	t.Run("Items that can't be used cost nothing", func(t *testing.T) {
+		defer os.Remove(GetWalletPath())
+		SaveWallet(Wallet{Balance: 100})
+		p := Pet{Name: "Tester", Health: MaxStat, Inventory: map[string]int{FoodKibble: GetFoodDefinition(FoodKibble).MaxStock}}
+
+		for _, id := range []string{ShopVitamins, FoodKibble} {
+			if _, ok := p.Buy(id); ok {
+				t.Errorf("Expected %s to be refused", id)
+			}
+		}
+		if LoadWallet().Balance != 100 {
+			t.Error("Expected refused purchases to keep the coins")
+		}
+	})
+
+	t.Run("Toys make their game more fun", func(t *testing.T) {
+		defer os.Remove(GetWalletPath())
+		SaveWallet(Wallet{Balance: 100})
+		newPet := func() Pet {
+			return Pet{Name: "Tester", Hunger: 80, Happiness: 40, Energy: 90, Health: 80, Bond: MaxBond, Mood: "normal"}
+		}
+		plain, toyed := newPet(), newPet()
+		if _, ok := toyed.Buy(ShopBall); !ok || !toyed.HasToy(ShopBall) {
+			t.Fatal("Expected to buy a ball")
+		}
+		if _, ok := toyed.Buy(ShopBall); ok {
+			t.Error("Expected a second ball to be refused")
+		}
+		plain.Play(PlayFetch)
+		toyed.Play(PlayFetch)
+		if toyed.Happiness <= plain.Happiness {
+			t.Errorf("Expected the ball to boost fetch, got %d vs %d", toyed.Happiness, plain.Happiness)
+		}
+	})
+
+	t.Run("Cosmetics are bought once and worn by any pet", func(t *testing.T) {
+		defer os.Remove(GetWalletPath())
+		SaveWallet(Wallet{Balance: economy.Prices[ShopBow]})
+		p := Pet{Name: "Tester"}
+		if _, ok := p.Buy(ShopBow); !ok || p.Cosmetic != ShopBow {
+			t.Fatalf("Expected the bow to be worn, got %q", p.Cosmetic)
+		}
+
+		heir := Pet{Name: "Heir"}
+		if _, ok := heir.Buy(ShopBow); !ok || heir.Cosmetic != ShopBow {
+			t.Error("Expected an owned cosmetic to be worn for free")
+		}
+		if w := LoadWallet(); w.Balance != 0 || len(w.Transactions) != 1 {
+			t.Errorf("Expected only one charge for the bow, got %+v", w)
+		}
+	})
+
+	t.Run("Quest rewards and chase catches pay out", func(t *testing.T) {
+		defer os.Remove(GetWalletPath())
+		p := Pet{Name: "Tester", Inventory: map[string]int{}}
+		p.grantQuestReward(*GetQuestDefinition(QuestRespondEvents))
+		if len(p.PendingCoins) != 1 || p.PendingCoins[0].Amount != economy.QuestReward {
+			t.Errorf("Expected a quest to earn %d coins, got %+v", economy.QuestReward, p.PendingCoins)
+		}
+
+		DepositCoins(economy.ChaseCatchReward, "Caught a butterfly")
+		if LoadWallet().Balance != economy.ChaseCatchReward {
+			t.Error("Expected the chase catch to be deposited")
+		}
+	})
+
+	t.Run("Chase rewards are capped per day and skip dead pets", func(t *testing.T) {
+		defer os.Remove(GetWalletPath())
+		defer os.Remove(GetAchievementsPath())
+		p := Pet{Name: "Tester", TimeZone: "UTC"}
+
+		for i := 0; i < economy.ChaseDailyCap; i++ {
+			if reward, _ := RewardChaseCatch(&p, "butterfly"); reward != economy.ChaseCatchReward {
+				t.Fatalf("Expected catch %d to pay %d, got %d", i+1, economy.ChaseCatchReward, reward)
+			}
+		}
+		if reward, _ := RewardChaseCatch(&p, "butterfly"); reward != 0 {
+			t.Errorf("Expected no reward past the daily cap, got %d", reward)
+		}
+		if balance := LoadWallet().Balance; balance != economy.ChaseDailyCap*economy.ChaseCatchReward {
+			t.Errorf("Expected only capped catches to be paid, got balance %d", balance)
+		}
+		if catches := LoadAchievements().ChaseCatches; catches != economy.ChaseDailyCap {
+			t.Errorf("Expected only rewarded catches to count toward achievements, got %d", catches)
+		}
+
+		TimeNow = func() time.Time { return now.Add(24 * time.Hour) }
+		defer func() { TimeNow = func() time.Time { return now } }()
+		if reward, _ := RewardChaseCatch(&p, "butterfly"); reward != economy.ChaseCatchReward {
+			t.Errorf("Expected the cap to reset the next day, got %d", reward)
+		}
+
+		dead := Pet{Name: "Tester", Dead: true}
+		if reward, earned := RewardChaseCatch(&dead, "butterfly"); reward != 0 || earned != nil {
+			t.Errorf("Expected no rewards for a dead pet, got %d and %v", reward, earned)
+		}
+	})
+
+	t.Run("Transaction log is capped", func(t *testing.T) {
+		var w Wallet
+		for i := 0; i < MaxTransactionLog+5; i++ {
+			w.record(Transaction{Amount: 1})
+		}
+		if len(w.Transactions) != MaxTransactionLog || w.Balance != MaxTransactionLog+5 {
+			t.Errorf("Expected %d entries and the full balance, got %d and %d", MaxTransactionLog, len(w.Transactions), w.Balance)
+		}
+	})
+}
+
+func TestCosmetics(t *testing.T) {
+	t.Run("Display width counts emoji presentation as wide", func(t *testing.T) {
+		tests := map[string]int{"abc": 3, "🎩": 2, "🕶️": 2, "🕶️🐱 Mochi": 10, "": 0}
+		for s, want := range tests {
+			if got := DisplayWidth(s); got != want {
+				t.Errorf("DisplayWidth(%q) = %d, want %d", s, got, want)
+			}
+		}
+	})
+
+	t.Run("Padding fills or truncates to the display width", func(t *testing.T) {
+		if got := PadDisplay("🎩x", 5); got != "🎩x  " {
+			t.Errorf("Expected padding to 5 columns, got %q", got)
+		}
+		if got := PadDisplay("ab🎩cd", 3); got != "ab " {
+			t.Errorf("Expected a wide rune that doesn't fit to be dropped, got %q", got)
+		}
+	})
+
+	t.Run("Dressed emoji wears the equipped cosmetic", func(t *testing.T) {
+		p := Pet{Form: FormBaby}
+		if p.GetDressedEmoji() != p.GetFormEmoji() {
+			t.Error("Expected no cosmetic by default")
+		}
+		p.Cosmetic = ShopCrown
+		if p.GetDressedEmoji() != "👑"+p.GetFormEmoji() {
+			t.Errorf("Expected the crown beside the form, got %q", p.GetDressedEmoji())
+		}
+
+		p.Cosmetic = ShopVitamins
+		if p.GetCosmeticEmoji() != "" {
+			t.Error("Expected only cosmetics to be worn")
+		}
+	})
+
+	t.Run("Every cosmetic is two columns wide", func(t *testing.T) {
+		for _, item := range GetShopItems() {
+			if item.Category == ShopCategoryCosmetic && DisplayWidth(item.Emoji) != 2 {
+				t.Errorf("Cosmetic %s is %d columns wide", item.ID, DisplayWidth(item.Emoji))
+			}
+		}
+	})
+}
+
+func TestRenderingProfile(t *testing.T) {
+	t.Cleanup(func() { SetProfile(ProfileEmoji) })
+	isASCII := func(s string) bool {
+		for _, r := range s {
+			if r > unicode.MaxASCII {
+				return false
+			}
+		}
+		return true
+	}
+
+	t.Run("Emoji profile leaves text alone", func(t *testing.T) {
+		if err := SetProfile(ProfileEmoji); err != nil {
+			t.Fatal(err)
+		}
+		if got := RenderText("😸 Happy"); got != "😸 Happy" {
+			t.Errorf("Expected text unchanged, got %q", got)
+		}
+	})
+
+	t.Run("Unknown profiles are rejected", func(t *testing.T) {
+		if err := SetProfile("sixel"); err == nil {
+			t.Error("Expected an error for an unknown profile")
+		}
+	})
+
+	t.Run("ASCII profile maps every emoji vpet uses", func(t *testing.T) {
+		SetProfile(ProfileASCII)
+		defer SetProfile(ProfileEmoji)
+
+		emoji := []string{StatusEmojiHappy, StatusEmojiNeutral, StatusEmojiSleeping, StatusEmojiHungry, StatusEmojiSad,
+			StatusEmojiEnergetic, StatusEmojiExcited, StatusEmojiSick, StatusEmojiDirty, StatusEmojiTired, StatusEmojiDead,
+			StatusEmojiVacation, StatusEmojiQuiet, CoinEmoji}
+		for _, def := range GetFormDefinitions() {
+			emoji = append(emoji, def.Emoji)
+		}
+		for _, def := range GetEventDefinitions() {
+			emoji = append(emoji, def.Emoji)
+		}
+		for _, def := range GetFoodDefinitions() {
+			emoji = append(emoji, def.Emoji)
+		}
+		for _, def := range GetPlayActivityDefinitions() {
+			emoji = append(emoji, def.Emoji)
+		}
+		for _, def := range GetTrickDefinitions() {
+			emoji = append(emoji, def.Emoji)
+		}
+		for _, def := range GetDiseaseDefinitions() {
+			emoji = append(emoji, def.Emoji)
+		}
+		for _, def := range GetWeightClassDefinitions() {
+			emoji = append(emoji, def.Emoji)
+		}
+		for _, def := range GetQuestDefinitions() {
+			emoji = append(emoji, def.Emoji)
+		}
+		for _, def := range GetAchievementDefinitions() {
+			emoji = append(emoji, def.Emoji)
+		}
+		for _, item := range GetShopItems() {
+			emoji = append(emoji, item.Emoji)
+		}
+
+		for _, e := range emoji {
+			got := RenderText(e)
+			sparkle := e == "✨" || e == "🌟" || e == "💫"
+			if !isASCII(got) || got == "" || (got == "*" && !sparkle) {
+				t.Errorf("Expected an ASCII token for %q, got %q", e, got)
+			}
+		}
+	})
+
+	t.Run("Variation selectors and unknown pictographs are handled", func(t *testing.T) {
+		SetProfile(ProfileASCII)
+		defer SetProfile(ProfileEmoji)
+
+		if got := RenderText("🕶️ and 🕶"); got != "(shades) and (shades)" {
+			t.Errorf("Expected both spellings to map the same, got %q", got)
+		}
+		if got := RenderText("🦄 Mochi"); got != "* Mochi" {
+			t.Errorf("Expected an unknown emoji to become *, got %q", got)
+		}
+	})
+
+	t.Run("Status functions follow the profile but saved state doesn't", func(t *testing.T) {
+		cleanup := setupTestFile(t)
+		defer cleanup()
+		SetProfile(ProfileASCII)
+		defer SetProfile(ProfileEmoji)
+
+		p := NewPet(nil)
+		p.Hunger = 10
+		if status := GetStatus(p); status != "=^_^=:O" {
+			t.Errorf("Expected an ASCII status, got %q", status)
+		}
+		if label := GetStatusWithLabel(p); label != "=^_^=:O Hungry" {
+			t.Errorf("Expected an ASCII label, got %q", label)
+		}
+		if !isASCII(p.GetFormEmoji()) {
+			t.Errorf("Expected an ASCII form, got %q", p.GetFormEmoji())
+		}
+
+		SaveState(&p)
+		if p.LastStatus != StatusEmojiHappy+StatusEmojiHungry {
+			t.Errorf("Expected the saved status to stay in emoji, got %q", p.LastStatus)
+		}
+	})
+}
+
+func TestThemes(t *testing.T) {
+	cleanup := setupTestFile(t)
+	defer cleanup()
+
+	t.Run("Auto follows the terminal background", func(t *testing.T) {
+		dark, err := LoadTheme(ThemeAuto, true)
+		if err != nil || dark.Name != ThemeDark {
+			t.Errorf("Expected the dark theme on a dark background, got %q (%v)", dark.Name, err)
+		}
+		light, err := LoadTheme("", false)
+		if err != nil || light.Name != ThemeLight {
+			t.Errorf("Expected the light theme on a light background, got %q (%v)", light.Name, err)
+		}
+	})
+
+	t.Run("Built-in themes load by name", func(t *testing.T) {
+		for _, name := range []string{ThemeDark, ThemeLight, ThemeHighContrast, ThemeMonochrome} {
+			theme, err := LoadTheme(name, true)
+			if err != nil || theme.Name != name {
+				t.Errorf("Expected built-in theme %q, got %q (%v)", name, theme.Name, err)
+			}
+		}
+		if mono := *GetBuiltinTheme(ThemeMonochrome); mono.Primary != "" || mono.Accent != "" {
+			t.Errorf("Expected monochrome to use no colors, got %+v", mono)
+		}
+	})
+
+	t.Run("User themes overlay their base", func(t *testing.T) {
+		if err := os.MkdirAll(GetThemesDir(), 0755); err != nil {
+			t.Fatal(err)
+		}
+		data := []byte(`{"base": "high-contrast", "primary": "#00FF00"}`)
+		if err := os.WriteFile(filepath.Join(GetThemesDir(), "matrix.json"), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		theme, err := LoadTheme("matrix", true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		base := GetBuiltinTheme(ThemeHighContrast)
+		if theme.Name != "matrix" || theme.Primary != "#00FF00" || theme.Accent != base.Accent || !theme.Bold {
+			t.Errorf("Expected matrix on top of high-contrast, got %+v", theme)
+		}
+
+		names := GetThemeNames()
+		if names[len(names)-1] != "matrix" {
+			t.Errorf("Expected user themes to be listed, got %v", names)
+		}
+	})
+
+	t.Run("User themes default to the background's base", func(t *testing.T) {
+		data := []byte(`{"alert": "#FFFFFF"}`)
+		if err := os.WriteFile(filepath.Join(GetThemesDir(), "calm.json"), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		theme, err := LoadTheme("calm", false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if theme.Primary != GetBuiltinTheme(ThemeLight).Primary || theme.Alert != "#FFFFFF" {
+			t.Errorf("Expected calm on top of light, got %+v", theme)
+		}
+	})
+
+	t.Run("Unknown themes and bases are errors", func(t *testing.T) {
+		if _, err := LoadTheme("solarized", true); err == nil {
+			t.Error("Expected an error for a missing theme")
+		}
+		data := []byte(`{"base": "solarized"}`)
+		if err := os.WriteFile(filepath.Join(GetThemesDir(), "broken.json"), data, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadTheme("broken", true); err == nil {
+			t.Error("Expected an error for an unknown base theme")
+		}
+	})
+}
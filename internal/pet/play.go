@@ -0,0 +1,209 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+)
+
+// Play activity constants
+const (
+	PlayFetch  = "fetch"
+	PlayTug    = "tug"
+	PlayPuzzle = "puzzle"
+	PlayLaser  = "laser"
+)
+
+// PlayActivityDefinition describes a way to play and what it takes to unlock
+type PlayActivityDefinition struct {
+	Type         string
+	Name         string
+	Emoji        string
+	Message      string
+	Happiness    int
+	Energy       int // Energy spent
+	Hunger       int // Hunger spent
+	Exercise     int // Weight burned off
+	BondBonus    int // Extra bond on top of the usual gain for a fresh session
+	MinAge       int // Hours
+	MinBond      int
+	LazyFriendly bool               // Can be played even in a lazy mood
+	MoodBonus    map[string]float64 // mood -> happiness multiplier
+	TraitBonus   map[string]float64 // trait name -> happiness multiplier
+}
+
+// GetPlayActivityDefinitions returns every play activity in menu order
+func GetPlayActivityDefinitions() []PlayActivityDefinition {
+	return []PlayActivityDefinition{
+		{
+			Type:       PlayFetch,
+			Name:       "Fetch",
+			Emoji:      "🎾",
+			Message:    "Wheee!",
+			Happiness:  PlayHappinessIncrease,
+			Energy:     PlayEnergyDecrease,
+			Hunger:     PlayHungerDecrease,
+			Exercise:   3,
+			MoodBonus:  map[string]float64{"playful": 1.2},
+			TraitBonus: map[string]float64{"Hyperactive": 1.2},
+		},
+		{
+			Type:       PlayTug,
+			Name:       "Tug of War",
+			Emoji:      "🪢",
+			Message:    "Grrr... tug tug!",
+			Happiness:  25,
+			Energy:     15,
+			Hunger:     5,
+			Exercise:   3,
+			BondBonus:  1,
+			MinBond:    TugUnlockBond,
+			MoodBonus:  map[string]float64{"needy": 1.3},
+			TraitBonus: map[string]float64{"Needy": 1.2, "Lap Cat": 1.2, "Independent": 0.8},
+		},
+		{
+			Type:         PlayPuzzle,
+			Name:         "Puzzle Toy",
+			Emoji:        "🧩",
+			Message:      "Figured it out!",
+			Happiness:    20,
+			Energy:       5,
+			Hunger:       3,
+			Exercise:     1,
+			MinAge:       PuzzleUnlockAge,
+			LazyFriendly: true,
+			MoodBonus:    map[string]float64{"lazy": 1.2},
+			TraitBonus:   map[string]float64{"Calm": 1.3, "Curious": 1.3, "Explorer": 1.3, "Hyperactive": 0.7},
+		},
+		{
+			Type:       PlayLaser,
+			Name:       "Laser Pointer",
+			Emoji:      "🔦",
+			Message:    "Must... catch... dot!",
+			Happiness:  35,
+			Energy:     20,
+			Hunger:     8,
+			Exercise:   5,
+			MinBond:    LaserUnlockBond,
+			MoodBonus:  map[string]float64{"playful": 1.3, "lazy": 0.5},
+			TraitBonus: map[string]float64{"Hyperactive": 1.3, "Explorer": 1.2, "Calm": 0.8},
+		},
+	}
+}
+
+// GetPlayActivityDefinition returns the definition for an activity type
+func GetPlayActivityDefinition(activity string) *PlayActivityDefinition {
+	for _, def := range GetPlayActivityDefinitions() {
+		if def.Type == activity {
+			return &def
+		}
+	}
+	return nil
+}
+
+// IsPlayActivityUnlocked reports whether the pet is old and bonded enough for an activity
+func (p *Pet) IsPlayActivityUnlocked(def PlayActivityDefinition) bool {
+	return p.Age >= def.MinAge && p.Bond >= def.MinBond
+}
+
+// GetUnlockHint describes what an activity needs before it unlocks
+func (def PlayActivityDefinition) GetUnlockHint() string {
+	switch {
+	case def.MinAge > 0 && def.MinBond > 0:
+		return fmt.Sprintf("age %dh, bond %d", def.MinAge, def.MinBond)
+	case def.MinAge > 0:
+		return fmt.Sprintf("age %dh", def.MinAge)
+	case def.MinBond > 0:
+		return fmt.Sprintf("bond %d", def.MinBond)
+	default:
+		return ""
+	}
+}
+
+// Play plays an activity with the pet. Returns a message and whether they played.
+func (p *Pet) Play(activity string) (string, bool) {
+	def := GetPlayActivityDefinition(activity)
+	if def == nil {
+		return fmt.Sprintf("❓ Unknown activity %q", activity), false
+	}
+	if p.Dead {
+		return "", false
+	}
+	if !p.IsPlayActivityUnlocked(*def) {
+		return fmt.Sprintf("🔒 %s unlocks at %s", def.Name, def.GetUnlockHint()), false
+	}
+	if p.Energy < AutoSleepThreshold {
+		return fmt.Sprintf("%s Too tired to play...", StatusEmojiSleeping), false
+	}
+	if p.Mood == "lazy" && p.Energy < 50 && !def.LazyFriendly {
+		return "😪 Not in the mood to play...", false
+	}
+	if msg, refused := p.CheckTraitRefusal("play"); refused {
+		return msg, false
+	}
+
+	isActive := p.IsActiveAt(TimeNow())
+	recentPlays := CountRecentInteractions(p.LastInteractions, "play", SpamPreventionWindow)
+	happinessBefore := p.Happiness
+
+	p.Sleeping = false
+	p.AutoSleepTime = nil
+	p.FractionalEnergy = 0
+
+	effectiveness := 1.0
+	if recentPlays > 0 {
+		effectiveness = 1.0 / float64(recentPlays+1)
+	}
+
+	bondMultiplier := p.GetBondMultiplier()
+	happinessGain := float64(def.Happiness)
+	if !isActive {
+		happinessGain *= OutsideActiveHappinessMult
+	}
+	happinessGain *= p.GetTraitModifier("play_bonus")
+	if mult, ok := def.MoodBonus[p.Mood]; ok {
+		happinessGain *= mult
+	}
+	for _, trait := range p.Traits {
+		if mult, ok := def.TraitBonus[trait.Name]; ok {
+			happinessGain *= mult
+		}
+	}
+	if p.hasToyFor(def.Type) {
+		happinessGain *= GetTuning().Economy.ToyPlayBonus
+	}
+	happinessGain *= bondMultiplier * effectiveness
+
+	p.Happiness = min(p.Happiness+int(happinessGain), MaxStat)
+	p.Energy = max(p.Energy-def.Energy, MinStat)
+	p.Hunger = max(p.Hunger-def.Hunger, MinStat)
+	p.ChangeWeight(-def.Exercise)
+	p.AddInteraction("play")
+	p.LastInteractions[len(p.LastInteractions)-1].Activity = def.Type
+	p.AddTraitExperience(ExperiencePlay)
+
+	if recentPlays == 0 && happinessBefore < 50 {
+		p.UpdateBond(BondGainWellTimed + def.BondBonus)
+		p.AddTraitExperience(ExperienceWellTimedCare)
+	} else if recentPlays == 0 {
+		p.UpdateBond(BondGainNormal + def.BondBonus)
+	}
+
+	log.Printf("Played %s with pet (effectiveness: %.2f, bond mult: %.2f). Happiness is now %d, Energy is now %d, Hunger is now %d",
+		def.Type, effectiveness, bondMultiplier, p.Happiness, p.Energy, p.Hunger)
+
+	if !isActive {
+		return "🥱 *yawn* ...play time...", true
+	}
+	if p.Mood == "playful" {
+		return fmt.Sprintf("🎉 %s So much fun!", def.Emoji), true
+	}
+	return fmt.Sprintf("%s %s", def.Emoji, def.Message), true
+}
+
+// GetPlayMenuLabel returns an activity's menu entry, showing its unlock requirement while locked
+func GetPlayMenuLabel(p Pet, def PlayActivityDefinition) string {
+	if !p.IsPlayActivityUnlocked(def) {
+		return fmt.Sprintf("🔒 %s (%s)", def.Name, def.GetUnlockHint())
+	}
+	return fmt.Sprintf("%s %s", def.Emoji, def.Name)
+}
@@ -0,0 +1,95 @@
+package pet
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Rendering profiles
+const (
+	ProfileEmoji = "emoji" // Emoji everywhere (default)
+	ProfileASCII = "ascii" // Plain ASCII for terminals without emoji or box-drawing support
+)
+
+var activeProfile = ProfileEmoji
+
+// SetProfile selects the rendering profile used by RenderText
+func SetProfile(name string) error {
+	switch name {
+	case ProfileEmoji, ProfileASCII:
+		activeProfile = name
+		return nil
+	}
+	return fmt.Errorf("unknown rendering profile %q (want %s or %s)", name, ProfileEmoji, ProfileASCII)
+}
+
+// IsASCIIProfile reports whether output should be plain ASCII
+func IsASCIIProfile() bool {
+	return activeProfile == ProfileASCII
+}
+
+// asciiGlyphs maps every emoji and drawing character vpet prints to an ASCII token. Keys are single runes, so
+// emoji written with or without a variation selector map the same way.
+var asciiGlyphs = map[rune]string{
+	// Pet status
+	'😸': "=^_^=", '🙂': ":)", '😴': "zZ", '🙀': ":O", '😿': ":'(", '😼': ">:3", '😻': "*o*",
+	'🤢': ":S", '💩': "(mess)", '😾': ">:(", '💀': "x_x", '🏖': "(beach)", '🔕': "(quiet)",
+
+	// Forms
+	'🐣': "(chick)", '😊': "^_^", '😟': ":(", '🤒': "(fever)", '😃': ":D", '😒': ":/", '🤧': "(achoo)",
+	'🤓': "8-)", '😎': "B-)", '⭐': "(*)", '😺': "=^.^=", '🤕': "(hurt)", '🥰': "(love)", '🎭': "(mask)",
+	'🌈': "(rainbow)", '💪': "(strong)", '🔥': "(fire)", '🧙': "(wizard)", '😌': "(calm)", '🏅': "(medal)",
+	'😤': "(huff)", '👴': "(elder)", '🐉': "(dragon)",
+
+	// Other faces and people
+	'😋': ":P", '😣': ">_<", '😪': "-_-", '😰': "D:", '😵': "@_@", '🤔': "(hmm)", '🧐': "(hmm?)",
+	'🤗': "(hug)", '🤮': "(sick)", '🤷': "(shrug)", '🥱': "(yawn)", '🥺': "(plead)", '🙅': "(no)",
+	'🙋': "\\o", '👏': "(clap)", '✋': "(hand)", '🤝': "(deal)", '🏃': "(run)",
+
+	// Hearts
+	'❤': "<3", '💕': "<3<3", '💙': "<3", '💚': "<3", '💛': "<3", '🤍': "<3", '💔': "</3",
+
+	// Food, toys and items
+	'🍖': "(meat)", '🍪': "(cookie)", '🐟': "<><", '🥦': "(veg)", '🥗': "(salad)", '🍩': "(donut)",
+	'🍽': "(plate)", '🦴': "(bone)", '🎾': "(ball)", '⚽': "(ball)", '🪢': "(rope)", '🧩': "(puzzle)",
+	'🔦': "(torch)", '🔴': "(o)", '🧸': "(teddy)", '💊': "(pill)", '🩺': "(vet)", '🛁': "(bath)",
+	'🧹': "(broom)", '🧽': "(sponge)", '🫧': "oO", '🛌': "(bed)", '🗑': "(bin)", '🎁': "(gift)",
+	'🎀': "(bow)", '🕶': "(shades)", '🎩': "(hat)", '👑': "(crown)", '🪙': "$",
+
+	// Events and nature
+	'🦋': "}{", '🐁': "(mouse)", '🦉': "(owl)", '🐾': "(paws)", '⚡': "!!", '💭': "(...)", '🎵': "~",
+	'🎶': "~~", '💨': "~=", '🌀': "@", '🌙': "(moon)", '☀': "(sun)", '🌅': "(dawn)", '🌱': "(sprout)",
+	'✨': "*", '🌟': "*", '💫': "*", '★': "*", '⚪': "o", '🎉': "\\o/",
+
+	// Achievements, quests and the rest of the interface
+	'🏆': "(cup)", '🏹': "(archer)", '🎓': "(grad)", '🎯': "(goal)", '🎮': "(game)", '🔔': "(bell)",
+	'🔒': "(lock)", '🔄': "(cycle)", '📅': "(cal)", '⏰': "(clock)", '🕐': "(clock)", '✈': "(away)",
+	'🪦': "(RIP)", '⚠': "/!\\", '❓': "?", '✅': "[x]", '⬜': "[ ]", '•': "*", '→': "->",
+
+	// Box drawing and bars
+	'═': "=", '║': "|", '╔': "+", '╗': "+", '╚': "+", '╝': "+", '╠': "+", '╣': "+", '█': "#", '░': ".",
+}
+
+// RenderText passes display text through the active rendering profile. In the ASCII profile every known emoji
+// becomes its ASCII token, unknown pictographs become "*", and variation selectors and joiners are dropped.
+func RenderText(s string) string {
+	if activeProfile != ProfileASCII {
+		return s
+	}
+	var b strings.Builder
+	for _, r := range s {
+		if token, ok := asciiGlyphs[r]; ok {
+			b.WriteString(token)
+			continue
+		}
+		switch {
+		case r == variationSelectorEmoji || r == '\u200d':
+		case r > unicode.MaxASCII && unicode.Is(unicode.So, r):
+			b.WriteString("*")
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
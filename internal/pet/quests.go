@@ -0,0 +1,325 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// Quest type constants
+const (
+	QuestRespondEvents = "respond_events"
+	QuestHappyAllDay   = "happy_all_day"
+	QuestPlayActive    = "play_active"
+	QuestBath          = "bath"
+	QuestPractice      = "practice"
+	QuestVariedDiet    = "varied_diet"
+	QuestCheckup       = "checkup"
+)
+
+// QuestDefinition describes a daily goal and its reward
+type QuestDefinition struct {
+	Type        string
+	Name        string
+	Emoji       string
+	AllDay      bool                                    // Only judged once the day is over
+	Available   func(p *Pet) bool                       // nil means always offered
+	Completed   func(p *Pet, start, end time.Time) bool // Whether it was done within the day
+	RewardBond  int
+	RewardFood  string
+	RewardCount int
+}
+
+// Quest is one of the day's goals
+type Quest struct {
+	Type string `json:"type"`
+	Done bool   `json:"done,omitempty"`
+}
+
+// DailyQuests holds the goals for one day in the pet's time zone
+type DailyQuests struct {
+	Day    string  `json:"day"` // YYYY-MM-DD
+	Quests []Quest `json:"quests"`
+}
+
+// countInteractions counts interactions of a type within [start, end) that match an optional filter
+func (p *Pet) countInteractions(actionType string, start, end time.Time, match func(Interaction) bool) int {
+	count := 0
+	for _, interaction := range p.LastInteractions {
+		if interaction.Type != actionType || interaction.Time.Before(start) || !interaction.Time.Before(end) {
+			continue
+		}
+		if match == nil || match(interaction) {
+			count++
+		}
+	}
+	return count
+}
+
+// GetQuestDefinitions returns every quest that can be offered
+func GetQuestDefinitions() []QuestDefinition {
+	return []QuestDefinition{
+		{
+			Type: QuestRespondEvents, Name: "Respond to 2 events", Emoji: "🔔",
+			Completed: func(p *Pet, start, end time.Time) bool {
+				responded := 0
+				for _, entry := range p.EventLog {
+					if !entry.WasIgnored && !entry.Time.Before(start) && entry.Time.Before(end) {
+						responded++
+					}
+				}
+				return responded >= 2
+			},
+			RewardBond: 2,
+		},
+		{
+			Type: QuestHappyAllDay, Name: "Keep happiness above 60 all day", Emoji: "😊", AllDay: true,
+			Completed: func(p *Pet, start, end time.Time) bool {
+				checks := 0
+				for _, checkpoints := range p.StatCheckpoints {
+					for _, check := range checkpoints {
+						if check.Time.Before(start) || !check.Time.Before(end) {
+							continue
+						}
+						if check.Happiness <= QuestHappinessThreshold {
+							return false
+						}
+						checks++
+					}
+				}
+				return checks >= QuestMinCheckpoints
+			},
+			RewardBond: 3, RewardFood: FoodTreat, RewardCount: 1,
+		},
+		{
+			Type: QuestPlayActive, Name: "Play during active hours", Emoji: "🎾",
+			Completed: func(p *Pet, start, end time.Time) bool {
+				return p.countInteractions("play", start, end, func(i Interaction) bool {
+					return IsActiveHours(p, p.LocalTime(i.Time).Hour())
+				}) > 0
+			},
+			RewardBond: 1, RewardFood: FoodFish, RewardCount: 1,
+		},
+		{
+			Type: QuestBath, Name: "Give a bath", Emoji: "🛁",
+			Available: func(p *Pet) bool { return p.Cleanliness < QuestBathThreshold },
+			Completed: func(p *Pet, start, end time.Time) bool {
+				return p.countInteractions("clean", start, end, nil) > 0
+			},
+			RewardBond: 1,
+		},
+		{
+			Type: QuestPractice, Name: "Practice a trick", Emoji: "🎓",
+			Available: func(p *Pet) bool { return p.LifeStage != StageBaby },
+			Completed: func(p *Pet, start, end time.Time) bool {
+				return p.countInteractions("train", start, end, nil)+p.countInteractions("perform", start, end, nil) > 0
+			},
+			RewardBond: 1, RewardFood: FoodTreat, RewardCount: 1,
+		},
+		{
+			Type: QuestVariedDiet, Name: "Feed 2 different foods", Emoji: "🥗",
+			Completed: func(p *Pet, start, end time.Time) bool {
+				foods := make(map[string]bool)
+				p.countInteractions("feed", start, end, func(i Interaction) bool {
+					foods[i.Food] = true
+					return true
+				})
+				return len(foods) >= 2
+			},
+			RewardBond: 1, RewardFood: FoodVegetables, RewardCount: 2,
+		},
+		{
+			Type: QuestCheckup, Name: "Take your sick pet to the vet", Emoji: "🩺",
+			// Only once the illness shows or a checkup found it, so the quest doesn't give away an incubating one
+			Available: func(p *Pet) bool { return p.Illness || (p.Disease != nil && p.Disease.Diagnosed) },
+			Completed: func(p *Pet, start, end time.Time) bool {
+				return p.countInteractions("checkup", start, end, nil) > 0
+			},
+			RewardBond: 2,
+		},
+	}
+}
+
+// GetQuestDefinition returns the definition for a quest type, or nil if unknown
+func GetQuestDefinition(questType string) *QuestDefinition {
+	for _, def := range GetQuestDefinitions() {
+		if def.Type == questType {
+			return &def
+		}
+	}
+	return nil
+}
+
+// shuffleQuests randomly reorders quest definitions
+func shuffleQuests(defs []QuestDefinition) {
+	for i := len(defs) - 1; i > 0; i-- {
+		j := min(int(RandFloat64()*float64(i+1)), i)
+		defs[i], defs[j] = defs[j], defs[i]
+	}
+}
+
+// generateQuests deals the day's quests. Quests prompted by the pet's state, like a bath when
+// it's dirty, come first; the rest are filled from the everyday pool.
+func (p *Pet) generateQuests(day string) {
+	var prompted, everyday []QuestDefinition
+	for _, def := range GetQuestDefinitions() {
+		switch {
+		case def.Available == nil:
+			everyday = append(everyday, def)
+		case def.Available(p):
+			prompted = append(prompted, def)
+		}
+	}
+	shuffleQuests(prompted)
+	shuffleQuests(everyday)
+	candidates := append(prompted, everyday...)
+
+	p.Quests = &DailyQuests{Day: day}
+	for _, def := range candidates[:min(DailyQuestCount, len(candidates))] {
+		p.Quests.Quests = append(p.Quests.Quests, Quest{Type: def.Type})
+	}
+	log.Printf("New daily quests for %s", day)
+}
+
+// startOfDay returns local midnight for a YYYY-MM-DD day in the pet's time zone
+func (p *Pet) startOfDay(day string) time.Time {
+	start, err := time.ParseInLocation("2006-01-02", day, p.Location())
+	if err != nil {
+		log.Printf("Invalid quest day %q: %v", day, err)
+	}
+	return start
+}
+
+// judgeQuests marks quests done within [start, end), granting rewards. All-day quests are
+// only judged once the day is over. Returns a message for each completion.
+func (p *Pet) judgeQuests(start, end time.Time, dayOver bool) []string {
+	var messages []string
+	for i := range p.Quests.Quests {
+		quest := &p.Quests.Quests[i]
+		def := GetQuestDefinition(quest.Type)
+		if quest.Done || def == nil || (def.AllDay && !dayOver) || !def.Completed(p, start, end) {
+			continue
+		}
+		quest.Done = true
+		messages = append(messages, p.grantQuestReward(*def))
+	}
+
+	if p.Quests.AllDone() && p.LastQuestDay != p.Quests.Day {
+		previous := p.startOfDay(p.Quests.Day).AddDate(0, 0, -1).Format("2006-01-02")
+		if p.LastQuestDay == previous {
+			p.QuestStreak++
+		} else {
+			p.QuestStreak = 1
+		}
+		p.LastQuestDay = p.Quests.Day
+		p.BestQuestStreak = max(p.BestQuestStreak, p.QuestStreak)
+		message := fmt.Sprintf("🔥 All quests done! %d-day streak", p.QuestStreak)
+		if p.QuestStreak%QuestStreakBonusDays == 0 {
+			coins := GetTuning().Economy.StreakBonus
+			p.UpdateBond(QuestStreakBonusBond)
+			p.EarnCoins(coins, fmt.Sprintf("%d-day quest streak", p.QuestStreak))
+			message += fmt.Sprintf(", +%d bond and %d %s bonus", QuestStreakBonusBond, coins, CoinEmoji)
+		}
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// grantQuestReward applies a quest's reward and describes it
+func (p *Pet) grantQuestReward(def QuestDefinition) string {
+	message := fmt.Sprintf("🎯 Quest complete: %s!", def.Name)
+	if coins := GetTuning().Economy.QuestReward; coins > 0 {
+		p.EarnCoins(coins, "Quest: "+def.Name)
+		message += fmt.Sprintf(" +%d %s", coins, CoinEmoji)
+	}
+	if def.RewardBond > 0 {
+		p.UpdateBond(def.RewardBond)
+		message += fmt.Sprintf(" +%d bond", def.RewardBond)
+	}
+	if food := GetFoodDefinition(def.RewardFood); food != nil {
+		if p.Inventory == nil {
+			p.Inventory = make(map[string]int)
+		}
+		p.Inventory[food.Type] = min(p.Inventory[food.Type]+def.RewardCount, food.MaxStock)
+		message += fmt.Sprintf(" +%d %s", def.RewardCount, food.Emoji)
+	}
+	log.Print(message)
+	return message
+}
+
+// UpdateQuests closes out the previous day's quests if the day has changed, deals new ones,
+// and checks progress on today's. Returns messages for anything completed.
+func (p *Pet) UpdateQuests(now time.Time) []string {
+	if p.Dead {
+		return nil
+	}
+	var messages []string
+	today := p.LocalTime(now).Format("2006-01-02")
+
+	if p.Quests != nil && p.Quests.Day != today {
+		start := p.startOfDay(p.Quests.Day)
+		messages = append(messages, p.judgeQuests(start, start.AddDate(0, 0, 1), true)...)
+	}
+	if p.Quests == nil || p.Quests.Day != today {
+		p.generateQuests(today)
+	}
+	return append(messages, p.judgeQuests(p.startOfDay(today), now.Add(time.Second), false)...)
+}
+
+// AllDone reports whether every quest for the day is complete
+func (d DailyQuests) AllDone() bool {
+	for _, quest := range d.Quests {
+		if !quest.Done {
+			return false
+		}
+	}
+	return len(d.Quests) > 0
+}
+
+// CurrentQuestStreak returns the streak, or 0 if it lapsed by missing yesterday's quests
+func (p *Pet) CurrentQuestStreak(now time.Time) int {
+	today := p.LocalTime(now)
+	if p.LastQuestDay == today.Format("2006-01-02") || p.LastQuestDay == today.AddDate(0, 0, -1).Format("2006-01-02") {
+		return p.QuestStreak
+	}
+	return 0
+}
+
+// GetQuestSummary returns a compact quest progress line, e.g. "✅⬜⬜ 🔥3"
+func GetQuestSummary(p Pet) string {
+	if p.Quests == nil {
+		return "-"
+	}
+	summary := ""
+	for _, quest := range p.Quests.Quests {
+		if quest.Done {
+			summary += "✅"
+		} else {
+			summary += "⬜"
+		}
+	}
+	if streak := p.CurrentQuestStreak(TimeNow()); streak > 0 {
+		summary += fmt.Sprintf(" 🔥%d", streak)
+	}
+	return summary
+}
+
+// GetQuestLines describes each of today's quests with its status
+func GetQuestLines(p Pet) []string {
+	if p.Quests == nil {
+		return nil
+	}
+	var lines []string
+	for _, quest := range p.Quests.Quests {
+		def := GetQuestDefinition(quest.Type)
+		if def == nil {
+			continue
+		}
+		status := "⬜"
+		if quest.Done {
+			status = "✅"
+		}
+		lines = append(lines, fmt.Sprintf("%s %s %s", status, def.Emoji, def.Name))
+	}
+	return lines
+}
@@ -0,0 +1,306 @@
+package pet
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+// TimeRange is a span of time, such as a do-not-disturb window or a calendar meeting
+type TimeRange struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// QuietBlock is a recurring daily window, like working hours, when the pet shouldn't demand attention
+type QuietBlock struct {
+	Start string   `json:"start"`          // "09:00"
+	End   string   `json:"end"`            // "17:00", may wrap past midnight
+	Days  []string `json:"days,omitempty"` // "mon".."sun", empty for every day
+}
+
+// QuietHours is the owner's do-not-disturb configuration, shared by every pet
+type QuietHours struct {
+	Blocks   []QuietBlock `json:"blocks,omitempty"`
+	DND      *TimeRange   `json:"dnd,omitempty"`      // One-off do not disturb
+	Calendar string       `json:"calendar,omitempty"` // Local ICS file whose events count as busy
+}
+
+var weekdayNames = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+// GetQuietHoursPath returns the path to the quiet hours file, next to the pet state file
+func GetQuietHoursPath() string {
+	return filepath.Join(filepath.Dir(GetConfigPath()), "quiet.json")
+}
+
+// LoadQuietHours loads the quiet hours, returning an empty schedule if none exists
+func LoadQuietHours() QuietHours {
+	var q QuietHours
+	data, err := os.ReadFile(GetQuietHoursPath())
+	if err != nil {
+		return q
+	}
+	if err := json.Unmarshal(data, &q); err != nil {
+		log.Printf("Error loading quiet hours: %v", err)
+	}
+	return q
+}
+
+// SaveQuietHours writes the quiet hours to disk
+func SaveQuietHours(q QuietHours) {
+	data, err := json.MarshalIndent(q, "", "  ")
+	if err != nil {
+		log.Printf("Error saving quiet hours: %v", err)
+		return
+	}
+	if err := os.WriteFile(GetQuietHoursPath(), data, 0644); err != nil {
+		log.Printf("Error writing quiet hours: %v", err)
+	}
+}
+
+// parseClock parses "HH:MM" into minutes after midnight
+func parseClock(clock string) (int, error) {
+	t, err := time.Parse("15:04", clock)
+	if err != nil {
+		return 0, fmt.Errorf("invalid time %q, expected HH:MM", clock)
+	}
+	return t.Hour()*60 + t.Minute(), nil
+}
+
+// NewQuietBlock parses a "09:00-17:00" window and optional comma-separated days
+func NewQuietBlock(window, days string) (QuietBlock, error) {
+	start, end, ok := strings.Cut(window, "-")
+	if !ok {
+		return QuietBlock{}, fmt.Errorf("invalid window %q, expected HH:MM-HH:MM", window)
+	}
+	for _, clock := range []string{start, end} {
+		if _, err := parseClock(clock); err != nil {
+			return QuietBlock{}, err
+		}
+	}
+	block := QuietBlock{Start: start, End: end}
+	if days != "" {
+		for _, day := range strings.Split(strings.ToLower(days), ",") {
+			day = strings.TrimSpace(day)
+			valid := false
+			for _, name := range weekdayNames {
+				valid = valid || day == name
+			}
+			if !valid {
+				return QuietBlock{}, fmt.Errorf("unknown day %q, expected mon..sun", day)
+			}
+			block.Days = append(block.Days, day)
+		}
+	}
+	if block.length() > MaxQuietHoursPerDay*time.Hour {
+		return QuietBlock{}, fmt.Errorf("quiet blocks can be at most %d hours", MaxQuietHoursPerDay)
+	}
+	return block, nil
+}
+
+// length returns how long the block lasts, or 0 if it's malformed
+func (b QuietBlock) length() time.Duration {
+	start, err := parseClock(b.Start)
+	if err != nil {
+		return 0
+	}
+	end, err := parseClock(b.End)
+	if err != nil {
+		return 0
+	}
+	if end <= start {
+		end += 24 * 60
+	}
+	return time.Duration(end-start) * time.Minute
+}
+
+// on reports whether the block applies on the given weekday
+func (b QuietBlock) on(day time.Weekday) bool {
+	if len(b.Days) == 0 {
+		return true
+	}
+	for _, name := range b.Days {
+		if name == weekdayNames[day] {
+			return true
+		}
+	}
+	return false
+}
+
+// String describes the block, e.g. "09:00-17:00 mon,tue"
+func (b QuietBlock) String() string {
+	if len(b.Days) == 0 {
+		return b.Start + "-" + b.End + " daily"
+	}
+	return b.Start + "-" + b.End + " " + strings.Join(b.Days, ",")
+}
+
+// busyRanges returns the raw quiet ranges that may touch the given local day
+func (q QuietHours) busyRanges(day time.Time, calendar []TimeRange) []TimeRange {
+	var ranges []TimeRange
+	for _, offset := range []int{-1, 0} {
+		blockDay := day.AddDate(0, 0, offset)
+		for _, block := range q.Blocks {
+			start, err := parseClock(block.Start)
+			if err != nil || !block.on(blockDay.Weekday()) {
+				continue
+			}
+			begin := blockDay.Add(time.Duration(start) * time.Minute)
+			ranges = append(ranges, TimeRange{Start: begin, End: begin.Add(block.length())})
+		}
+	}
+	if q.DND != nil {
+		ranges = append(ranges, *q.DND)
+	}
+	return append(ranges, calendar...)
+}
+
+// quietRangesForDay returns the merged quiet time within one local day, trimmed to the daily
+// allowance so quiet hours can't freeze the pet around the clock
+func (q QuietHours) quietRangesForDay(day time.Time, calendar []TimeRange) []TimeRange {
+	dayEnd := day.AddDate(0, 0, 1)
+	var clipped []TimeRange
+	for _, r := range q.busyRanges(day, calendar) {
+		start, end := r.Start, r.End
+		if start.Before(day) {
+			start = day
+		}
+		if end.After(dayEnd) {
+			end = dayEnd
+		}
+		if end.After(start) {
+			clipped = append(clipped, TimeRange{Start: start, End: end})
+		}
+	}
+	sort.Slice(clipped, func(i, j int) bool { return clipped[i].Start.Before(clipped[j].Start) })
+
+	var merged []TimeRange
+	for _, r := range clipped {
+		if n := len(merged); n > 0 && !r.Start.After(merged[n-1].End) {
+			if r.End.After(merged[n-1].End) {
+				merged[n-1].End = r.End
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+
+	// Keep the earliest quiet time up to the allowance
+	budget := MaxQuietHoursPerDay * time.Hour
+	var allowed []TimeRange
+	for _, r := range merged {
+		if budget <= 0 {
+			break
+		}
+		if r.End.Sub(r.Start) > budget {
+			r.End = r.Start.Add(budget)
+		}
+		budget -= r.End.Sub(r.Start)
+		allowed = append(allowed, r)
+	}
+	return allowed
+}
+
+// loadCalendar reads a calendar's busy blocks; tests replace it to count reads
+var loadCalendar = LoadCalendarBusy
+
+// QuietSchedule is the quiet hours resolved for one time zone, with the calendar already read
+// so a load can check it many times without going back to the disk. The zero value is never quiet.
+type QuietSchedule struct {
+	hours    QuietHours
+	calendar []TimeRange
+	loc      *time.Location
+}
+
+// Schedule resolves the quiet hours in the given zone, reading the calendar once
+func (q QuietHours) Schedule(loc *time.Location) QuietSchedule {
+	s := QuietSchedule{hours: q, loc: loc}
+	if q.Calendar != "" {
+		busy, err := loadCalendar(q.Calendar, loc)
+		if err != nil {
+			log.Printf("Error reading calendar: %v", err)
+		}
+		s.calendar = busy
+	}
+	return s
+}
+
+// LoadQuietSchedule loads the quiet hours and calendar in the pet's time zone
+func (p *Pet) LoadQuietSchedule() QuietSchedule {
+	return LoadQuietHours().Schedule(p.Location())
+}
+
+// OverlapHours returns how many quiet hours fall between from and to
+func (s QuietSchedule) OverlapHours(from, to time.Time) float64 {
+	if !to.After(from) || s.loc == nil {
+		return 0
+	}
+	local := from.In(s.loc)
+	day := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, s.loc)
+
+	total := 0.0
+	for ; day.Before(to); day = day.AddDate(0, 0, 1) {
+		for _, r := range s.hours.quietRangesForDay(day, s.calendar) {
+			total += overlapHours(from, to, r.Start, r.End)
+		}
+	}
+	return total
+}
+
+// IsQuiet reports whether the owner asked not to be disturbed at the given moment
+func (s QuietSchedule) IsQuiet(t time.Time) bool {
+	return s.OverlapHours(t, t.Add(time.Minute)) > 0
+}
+
+// OverlapHours returns how many quiet hours fall between from and to in the given zone
+func (q QuietHours) OverlapHours(from, to time.Time, loc *time.Location) float64 {
+	return q.Schedule(loc).OverlapHours(from, to)
+}
+
+// IsQuiet reports whether the given moment falls in quiet hours
+func (q QuietHours) IsQuiet(t time.Time, loc *time.Location) bool {
+	return q.Schedule(loc).IsQuiet(t)
+}
+
+// QuietHoursBetween returns the quiet time between from and to that isn't already covered by a vacation
+func (p *Pet) QuietHoursBetween(quiet QuietSchedule, from, to time.Time) float64 {
+	hours := quiet.OverlapHours(from, to)
+	for _, span := range p.vacationSpans() {
+		start, end := from, to
+		if span.Start.After(start) {
+			start = span.Start
+		}
+		if span.End.Before(end) {
+			end = span.End
+		}
+		hours -= quiet.OverlapHours(start, end)
+	}
+	return hours
+}
+
+// GetQuietHoursDisplay describes the configured quiet hours
+func GetQuietHoursDisplay(q QuietHours, loc *time.Location) string {
+	var lines []string
+	for i, block := range q.Blocks {
+		lines = append(lines, fmt.Sprintf("%d. %s", i+1, block))
+	}
+	if q.DND != nil && TimeNow().Before(q.DND.End) {
+		lines = append(lines, "Do not disturb until "+q.DND.End.In(loc).Format("Mon 15:04"))
+	}
+	if q.Calendar != "" {
+		lines = append(lines, "Calendar: "+q.Calendar)
+	}
+	if len(lines) == 0 {
+		return "No quiet hours set"
+	}
+	status := "🔔 Not quiet right now"
+	if q.IsQuiet(TimeNow(), loc) {
+		status = StatusEmojiQuiet + " Quiet right now"
+	}
+	return strings.Join(append(lines, status), "\n")
+}
@@ -0,0 +1,109 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+	"math"
+	"time"
+)
+
+// Schedule is the pet's active window, seeded from its chronotype and adapted
+// over time to when its owner actually visits
+type Schedule struct {
+	WakeHour    int       `json:"wake_hour"`
+	SleepHour   int       `json:"sleep_hour"`
+	LastAdapted time.Time `json:"last_adapted"`
+}
+
+// GetSchedule returns the pet's (wakeHour, sleepHour), falling back to its chronotype's preset
+func (p *Pet) GetSchedule() (int, int) {
+	if p.Schedule != nil {
+		return p.Schedule.WakeHour, p.Schedule.SleepHour
+	}
+	return GetChronotypeSchedule(p.Chronotype)
+}
+
+// IsCustomSchedule reports whether the pet's schedule has drifted away from its chronotype preset
+func (p *Pet) IsCustomSchedule() bool {
+	wake, sleep := p.GetSchedule()
+	presetWake, presetSleep := GetChronotypeSchedule(p.Chronotype)
+	return wake != presetWake || sleep != presetSleep
+}
+
+// hourDiff returns the signed shortest distance from one hour of the day to another
+func hourDiff(from, to float64) float64 {
+	return math.Mod(to-from+36, 24) - 12
+}
+
+// OwnerActiveCenter returns the average hour of day the owner interacted with the pet
+// within the learning window, and whether there were enough interactions to tell
+func (p *Pet) OwnerActiveCenter(now time.Time) (float64, bool) {
+	var sinSum, cosSum float64
+	count := 0
+	for _, interaction := range p.LastInteractions {
+		if now.Sub(interaction.Time) > ScheduleLearningWindow {
+			continue
+		}
+		local := p.LocalTime(interaction.Time)
+		hour := float64(local.Hour()) + float64(local.Minute())/60
+		angle := hour / 24 * 2 * math.Pi
+		sinSum += math.Sin(angle)
+		cosSum += math.Cos(angle)
+		count++
+	}
+	if count < ScheduleMinInteractions || (sinSum == 0 && cosSum == 0) {
+		return 0, false
+	}
+
+	center := math.Atan2(sinSum, cosSum) / (2 * math.Pi) * 24
+	return math.Mod(center+24, 24), true
+}
+
+// scheduleCenter returns the hour in the middle of an active window
+func scheduleCenter(wake, sleep int) float64 {
+	length := (sleep - wake + 24) % 24
+	return math.Mod(float64(wake)+float64(length)/2, 24)
+}
+
+// AdaptSchedule shifts the pet's active window up to an hour a day toward the middle of
+// its owner's recent activity. Returns true if the schedule moved.
+func (p *Pet) AdaptSchedule(now time.Time) bool {
+	if p.Schedule != nil && now.Sub(p.Schedule.LastAdapted) < ScheduleAdaptInterval {
+		return false
+	}
+
+	ownerCenter, ok := p.OwnerActiveCenter(now)
+	if !ok {
+		return false
+	}
+
+	wake, sleep := p.GetSchedule()
+	if p.Schedule == nil {
+		p.Schedule = &Schedule{WakeHour: wake, SleepHour: sleep}
+	}
+	p.Schedule.LastAdapted = now
+
+	diff := hourDiff(scheduleCenter(wake, sleep), ownerCenter)
+	if math.Abs(diff) < 1 {
+		return false
+	}
+
+	shift := ScheduleMaxDrift
+	if diff < 0 {
+		shift = -ScheduleMaxDrift
+	}
+	p.Schedule.WakeHour = (wake + shift + 24) % 24
+	p.Schedule.SleepHour = (sleep + shift + 24) % 24
+	log.Printf("Schedule drifted toward owner's activity: %d:00-%d:00", p.Schedule.WakeHour, p.Schedule.SleepHour)
+	return true
+}
+
+// GetScheduleDisplay returns the pet's schedule with its chronotype, or "Custom" once
+// it has adapted away from the preset
+func GetScheduleDisplay(p Pet) string {
+	wake, sleep := p.GetSchedule()
+	if p.IsCustomSchedule() {
+		return fmt.Sprintf("⏰ Custom (%d:00-%d:00)", wake, sleep)
+	}
+	return fmt.Sprintf("%s %s (%d:00-%d:00)", GetChronotypeEmoji(p.Chronotype), GetChronotypeName(p.Chronotype), wake, sleep)
+}
@@ -0,0 +1,41 @@
+package pet
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// Settings holds display preferences shared by every vpet command
+type Settings struct {
+	Profile string `json:"profile,omitempty"` // Rendering profile: "emoji" or "ascii"; empty to detect
+	Theme   string `json:"theme,omitempty"`   // Theme name; empty to detect from the background
+}
+
+// GetSettingsPath returns the path to the settings file, next to the pet state file
+func GetSettingsPath() string {
+	return filepath.Join(filepath.Dir(GetConfigPath()), "settings.json")
+}
+
+// LoadSettings loads the settings, returning defaults if none exist
+func LoadSettings() Settings {
+	var s Settings
+	data, err := os.ReadFile(GetSettingsPath())
+	if err != nil {
+		return s
+	}
+	if err := json.Unmarshal(data, &s); err != nil {
+		log.Printf("Error loading settings: %v", err)
+	}
+	return s
+}
+
+// SaveSettings writes the settings to disk
+func SaveSettings(s Settings) error {
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(GetSettingsPath(), data, 0644)
+}
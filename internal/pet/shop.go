@@ -0,0 +1,188 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+)
+
+// Shop item IDs. Food is sold under its food type.
+const (
+	ShopBall       = "ball"
+	ShopRope       = "rope"
+	ShopPuzzleBox  = "puzzle_box"
+	ShopLaser      = "laser_pointer"
+	ShopVitamins   = "vitamins"
+	ShopBow        = "bow"
+	ShopSunglasses = "sunglasses"
+	ShopTopHat     = "top_hat"
+	ShopCrown      = "crown"
+)
+
+// Shop categories
+const (
+	ShopCategoryFood     = "food"
+	ShopCategoryToy      = "toy"
+	ShopCategoryMedicine = "medicine"
+	ShopCategoryCosmetic = "cosmetic"
+)
+
+// ShopItem describes something that can be bought with coins
+type ShopItem struct {
+	ID       string
+	Name     string
+	Emoji    string
+	Category string
+	Activity string                      // Play activity a toy improves
+	Give     func(p *Pet) (string, bool) // Hands the item over; false if it can't be bought right now
+}
+
+// GetShopItems returns everything for sale in menu order
+func GetShopItems() []ShopItem {
+	var items []ShopItem
+	for _, food := range GetFoodDefinitions() {
+		food := food
+		items = append(items, ShopItem{
+			ID: food.Type, Name: food.Name, Emoji: food.Emoji, Category: ShopCategoryFood,
+			Give: func(p *Pet) (string, bool) {
+				if p.Inventory[food.Type] >= food.MaxStock {
+					return fmt.Sprintf("%s The pantry is full of %s", food.Emoji, food.Name), false
+				}
+				if p.Inventory == nil {
+					p.Inventory = make(map[string]int)
+				}
+				p.Inventory[food.Type]++
+				return fmt.Sprintf("%s Added %s to the pantry", food.Emoji, food.Name), true
+			},
+		})
+	}
+
+	toys := []ShopItem{
+		{ID: ShopBall, Name: "Squeaky Ball", Emoji: "🎾", Activity: PlayFetch},
+		{ID: ShopRope, Name: "Rope Toy", Emoji: "🪢", Activity: PlayTug},
+		{ID: ShopPuzzleBox, Name: "Puzzle Box", Emoji: "🧩", Activity: PlayPuzzle},
+		{ID: ShopLaser, Name: "Laser Pointer", Emoji: "🔴", Activity: PlayLaser},
+	}
+	for _, toy := range toys {
+		toy := toy
+		toy.Category = ShopCategoryToy
+		toy.Give = func(p *Pet) (string, bool) {
+			if p.HasToy(toy.ID) {
+				return fmt.Sprintf("%s %s already has a %s", toy.Emoji, p.Name, toy.Name), false
+			}
+			p.Toys = append(p.Toys, toy.ID)
+			return fmt.Sprintf("%s %s loves the new %s!", toy.Emoji, p.Name, toy.Name), true
+		}
+		items = append(items, toy)
+	}
+
+	items = append(items, ShopItem{
+		ID: ShopVitamins, Name: "Vitamins", Emoji: "💊", Category: ShopCategoryMedicine,
+		Give: func(p *Pet) (string, bool) {
+			if p.Health >= MaxStat {
+				return "💊 Already in perfect health", false
+			}
+			p.Health = min(p.Health+GetTuning().Economy.VitaminsHealth, MaxStat)
+			return "💊 Vitamins! Feeling healthier", true
+		},
+	})
+
+	cosmetics := []ShopItem{
+		{ID: ShopBow, Name: "Bow", Emoji: "🎀"},
+		{ID: ShopSunglasses, Name: "Sunglasses", Emoji: "🕶️"},
+		{ID: ShopTopHat, Name: "Top Hat", Emoji: "🎩"},
+		{ID: ShopCrown, Name: "Crown", Emoji: "👑"},
+	}
+	for _, cosmetic := range cosmetics {
+		cosmetic := cosmetic
+		cosmetic.Category = ShopCategoryCosmetic
+		cosmetic.Give = func(p *Pet) (string, bool) {
+			p.Cosmetic = cosmetic.ID
+			return fmt.Sprintf("%s %s is wearing the %s", cosmetic.Emoji, p.Name, cosmetic.Name), true
+		}
+		items = append(items, cosmetic)
+	}
+	return items
+}
+
+// GetShopItem returns the shop item with an ID, or nil if unknown
+func GetShopItem(id string) *ShopItem {
+	for _, item := range GetShopItems() {
+		if item.ID == id {
+			return &item
+		}
+	}
+	return nil
+}
+
+// GetShopPrice returns an item's price from the tuning config
+func GetShopPrice(id string) int {
+	return GetTuning().Economy.Prices[id]
+}
+
+// HasToy reports whether the pet owns a toy
+func (p *Pet) HasToy(id string) bool {
+	for _, toy := range p.Toys {
+		if toy == id {
+			return true
+		}
+	}
+	return false
+}
+
+// hasToyFor reports whether the pet owns a toy for a play activity
+func (p *Pet) hasToyFor(activity string) bool {
+	for _, item := range GetShopItems() {
+		if item.Activity == activity && p.HasToy(item.ID) {
+			return true
+		}
+	}
+	return false
+}
+
+// Buy spends coins from the wallet on a shop item. Owned cosmetics are put back on for free.
+func (p *Pet) Buy(id string) (string, bool) {
+	item := GetShopItem(id)
+	if item == nil {
+		return "❓ That's not for sale", false
+	}
+
+	w := LoadWallet()
+	w.bankPending(p)
+	defer func() { SaveWallet(w) }()
+
+	if item.Category == ShopCategoryCosmetic && w.Owns(item.ID) {
+		return item.Give(p)
+	}
+
+	price := GetShopPrice(item.ID)
+	if w.Balance < price {
+		return fmt.Sprintf("%s Not enough coins (%d/%d)", CoinEmoji, w.Balance, price), false
+	}
+	message, ok := item.Give(p)
+	if !ok {
+		return message, false
+	}
+
+	w.record(Transaction{Time: TimeNow(), Amount: -price, Reason: "Bought " + item.Name})
+	if item.Category == ShopCategoryCosmetic {
+		w.Cosmetics = append(w.Cosmetics, item.ID)
+	}
+	log.Printf("Bought %s for %d coins", item.Name, price)
+	return fmt.Sprintf("%s (-%d %s)", message, price, CoinEmoji), true
+}
+
+// GetShopMenuLabel returns an item's shop entry with its price, or what the pet already has
+func GetShopMenuLabel(p Pet, w Wallet, item ShopItem) string {
+	label := fmt.Sprintf("%s %s", item.Emoji, item.Name)
+	switch {
+	case item.Category == ShopCategoryCosmetic && p.Cosmetic == item.ID:
+		return label + " (wearing)"
+	case item.Category == ShopCategoryCosmetic && w.Owns(item.ID):
+		return label + " (owned, wear)"
+	case item.Category == ShopCategoryToy && p.HasToy(item.ID):
+		return label + " (owned)"
+	case item.Category == ShopCategoryFood:
+		return fmt.Sprintf("%s - %d %s (have %d)", label, GetShopPrice(item.ID), CoinEmoji, p.Inventory[item.ID])
+	}
+	return fmt.Sprintf("%s - %d %s", label, GetShopPrice(item.ID), CoinEmoji)
+}
@@ -2,11 +2,19 @@ package pet
 
 import "strings"
 
-// GetStatus returns the status emoji(s) for the pet
+// GetStatus returns the status emoji(s) for the pet in the active rendering profile
 func GetStatus(p Pet) string {
+	return RenderText(statusEmoji(p))
+}
+
+// statusEmoji returns the status emoji(s) for the pet. State is tracked with these, whatever the profile.
+func statusEmoji(p Pet) string {
 	if p.Dead {
 		return StatusEmojiDead
 	}
+	if p.OnVacation(TimeNow()) {
+		return StatusEmojiVacation
+	}
 
 	// Icon 1: Activity (what pet is DOING)
 	var activity string
@@ -46,6 +54,10 @@ func GetStatus(p Pet) string {
 		lowestStat = p.Happiness
 		lowestFeeling = StatusEmojiSad // Sad
 	}
+	if p.Cleanliness < lowestStat {
+		lowestStat = p.Cleanliness
+		lowestFeeling = StatusEmojiDirty // Dirty
+	}
 
 	// Show critical feeling if any stat < 30
 	if lowestStat < 30 {
@@ -66,13 +78,19 @@ func GetStatus(p Pet) string {
 
 // GetStatusWithLabel returns status with text labels for the UI
 func GetStatusWithLabel(p Pet) string {
+	return RenderText(statusWithLabel(p))
+}
+
+func statusWithLabel(p Pet) string {
 	if p.Dead {
 		return "💀 Dead"
 	}
 
-	status := GetStatus(p)
+	status := statusEmoji(p)
 
 	switch {
+	case status == StatusEmojiVacation:
+		return status + " With a pet sitter"
 	case strings.Contains(status, StatusEmojiSleeping) && strings.Contains(status, StatusEmojiTired):
 		return status + " Sleeping"
 	case strings.Contains(status, StatusEmojiSleeping) && len(status) > 4:
@@ -97,12 +115,18 @@ func GetStatusWithLabel(p Pet) string {
 		return status + " Zoomies!"
 	case strings.Contains(status, "🥺") && strings.HasPrefix(status, "🥺"):
 		return status + " Wants cuddles!"
+	case strings.Contains(status, "🎓"):
+		return status + " Showing off a trick!"
 	case strings.Contains(status, StatusEmojiHungry):
 		return status + " Hungry"
 	case strings.Contains(status, StatusEmojiTired):
 		return status + " Tired"
 	case strings.Contains(status, StatusEmojiSad):
 		return status + " Sad"
+	case strings.Contains(status, StatusEmojiDirty):
+		return status + " Dirty"
+	case strings.Contains(status, "🧽"):
+		return status + " Wants a bath"
 	case strings.Contains(status, StatusEmojiSick):
 		return status + " Sick"
 	case strings.Contains(status, "🥱"):
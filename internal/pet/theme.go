@@ -0,0 +1,108 @@
+package pet
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// Built-in theme names
+const (
+	ThemeAuto         = "auto" // Dark or light, from the terminal background
+	ThemeDark         = "dark"
+	ThemeLight        = "light"
+	ThemeHighContrast = "high-contrast"
+	ThemeMonochrome   = "monochrome"
+)
+
+// Theme is the color scheme for the TUI, the stats popup and chase mode. Colors are hex ("#FF75B5") or ANSI
+// numbers ("13"); an empty color leaves the terminal's default.
+type Theme struct {
+	Name    string `json:"name,omitempty"`
+	Base    string `json:"base,omitempty"`    // Built-in theme a user theme starts from; defaults to the background's
+	Primary string `json:"primary,omitempty"` // Title, status, stats and menus
+	Accent  string `json:"accent,omitempty"`  // Action animations and the chase target
+	Alert   string `json:"alert,omitempty"`   // Cheat menu header
+	Border  string `json:"border,omitempty"`  // Stats popup box and hints
+	Bold    bool   `json:"bold,omitempty"`    // Bold all themed text
+}
+
+// GetBuiltinThemes returns the themes that ship with vpet
+func GetBuiltinThemes() []Theme {
+	return []Theme{
+		{Name: ThemeDark, Primary: "#FF75B5", Accent: "#FFD700", Alert: "#FF0000", Border: "#FF75B5"},
+		{Name: ThemeLight, Primary: "#C2185B", Accent: "#A05A00", Alert: "#C62828", Border: "#AD1457"},
+		{Name: ThemeHighContrast, Primary: "15", Accent: "11", Alert: "9", Border: "15", Bold: true},
+		{Name: ThemeMonochrome},
+	}
+}
+
+// GetBuiltinTheme returns a built-in theme by name, or nil if unknown
+func GetBuiltinTheme(name string) *Theme {
+	for _, theme := range GetBuiltinThemes() {
+		if theme.Name == name {
+			return &theme
+		}
+	}
+	return nil
+}
+
+// GetThemesDir returns the directory holding user theme files, next to the pet state file
+func GetThemesDir() string {
+	return filepath.Join(filepath.Dir(GetConfigPath()), "themes")
+}
+
+// GetThemeNames lists the built-in themes followed by any user themes
+func GetThemeNames() []string {
+	names := []string{ThemeAuto}
+	for _, theme := range GetBuiltinThemes() {
+		names = append(names, theme.Name)
+	}
+	files, _ := filepath.Glob(filepath.Join(GetThemesDir(), "*.json"))
+	sort.Strings(files)
+	for _, file := range files {
+		names = append(names, strings.TrimSuffix(filepath.Base(file), ".json"))
+	}
+	return names
+}
+
+// LoadTheme resolves a theme name. "auto" or "" picks dark or light from the background, built-in names return
+// that theme, and any other name loads themes/NAME.json on top of its base theme.
+func LoadTheme(name string, darkBackground bool) (Theme, error) {
+	background := ThemeLight
+	if darkBackground {
+		background = ThemeDark
+	}
+	if name == "" || name == ThemeAuto {
+		name = background
+	}
+	if builtin := GetBuiltinTheme(name); builtin != nil {
+		return *builtin, nil
+	}
+
+	data, err := os.ReadFile(filepath.Join(GetThemesDir(), name+".json"))
+	if err != nil {
+		return Theme{}, fmt.Errorf("unknown theme %q (available: %s)", name, strings.Join(GetThemeNames(), ", "))
+	}
+	var custom Theme
+	if err := json.Unmarshal(data, &custom); err != nil {
+		return Theme{}, fmt.Errorf("can't read theme %q: %v", name, err)
+	}
+
+	if custom.Base == "" {
+		custom.Base = background
+	}
+	base := GetBuiltinTheme(custom.Base)
+	if base == nil {
+		return Theme{}, fmt.Errorf("theme %q is based on unknown theme %q", name, custom.Base)
+	}
+	theme := *base
+	if err := json.Unmarshal(data, &theme); err != nil {
+		return Theme{}, fmt.Errorf("can't read theme %q: %v", name, err)
+	}
+	theme.Name = name
+	return theme, nil
+}
@@ -0,0 +1,110 @@
+package pet
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// localZoneName returns the IANA name of the machine's local time zone, or "" if it can't be determined
+func localZoneName() string {
+	if tz := os.Getenv("TZ"); tz != "" {
+		if _, err := time.LoadLocation(tz); err == nil {
+			return tz
+		}
+	}
+	if target, err := filepath.EvalSymlinks("/etc/localtime"); err == nil {
+		if i := strings.Index(target, "zoneinfo/"); i >= 0 {
+			name := target[i+len("zoneinfo/"):]
+			if _, err := time.LoadLocation(name); err == nil {
+				return name
+			}
+		}
+	}
+	return ""
+}
+
+// Location returns the time zone the pet lives in, falling back to the machine's local zone
+func (p *Pet) Location() *time.Location {
+	if p.TimeZone != "" {
+		if loc, err := time.LoadLocation(p.TimeZone); err == nil {
+			return loc
+		}
+	}
+	return time.Local
+}
+
+// LoadLocation reads the saved pet's time zone without loading, and so updating, the whole pet
+func LoadLocation() *time.Location {
+	var p Pet
+	if data, err := os.ReadFile(GetConfigPath()); err == nil {
+		if err := json.Unmarshal(data, &p); err != nil {
+			log.Printf("Error reading time zone: %v", err)
+		}
+	}
+	return p.Location()
+}
+
+// LocalTime converts t to the pet's time zone
+func (p *Pet) LocalTime(t time.Time) time.Time {
+	return t.In(p.Location())
+}
+
+// IsActiveAt reports whether the pet is in its active window at the given moment. Right after
+// a time zone change the pet is treated as active so the jump can't force it to sleep or drain.
+func (p *Pet) IsActiveAt(t time.Time) bool {
+	if p.InTimeZoneGrace(t) {
+		return true
+	}
+	return IsActiveHours(p, p.LocalTime(t).Hour())
+}
+
+// InTimeZoneGrace reports whether the pet is still adjusting to a recent time zone change
+func (p *Pet) InTimeZoneGrace(t time.Time) bool {
+	return p.TimeZoneChangedAt != nil && t.Sub(*p.TimeZoneChangedAt) < TimeZoneGracePeriod
+}
+
+// GetTimeZoneDisplay returns the pet's time zone and current local time
+func GetTimeZoneDisplay(p Pet) string {
+	loc := p.Location()
+	name := p.TimeZone
+	if name == "" {
+		name = "Local"
+	}
+	display := fmt.Sprintf("%s (%s)", name, TimeNow().In(loc).Format("15:04 MST"))
+	if p.InTimeZoneGrace(TimeNow()) {
+		display += " ✈️ adjusting"
+	}
+	return display
+}
+
+// SetTimeZone moves the pet to a new time zone. The pet gets a grace period to adjust, and
+// changes are limited to one per cooldown so zones can't be hopped to dodge the night.
+func (p *Pet) SetTimeZone(name string) (string, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return "", fmt.Errorf("unknown time zone %q", name)
+	}
+	if loc.String() == p.Location().String() {
+		return "", fmt.Errorf("%s already lives in %s", p.Name, loc)
+	}
+
+	now := TimeNow()
+	if p.TimeZoneChangedAt != nil {
+		if wait := TimeZoneChangeCooldown - now.Sub(*p.TimeZoneChangedAt); wait > 0 {
+			return "", fmt.Errorf("%s is still getting used to the last move; try again in %s", p.Name, wait.Round(time.Minute))
+		}
+	}
+
+	from := p.LocalTime(now)
+	p.TimeZone = loc.String()
+	p.TimeZoneChangedAt = &now
+	to := p.LocalTime(now)
+
+	return fmt.Sprintf("✈️ Clock moved from %s to %s (%s). %s gets %d hours to adjust.",
+		from.Format("15:04 MST"), to.Format("15:04 MST"), p.TimeZone, p.Name, int(TimeZoneGracePeriod.Hours())), nil
+}
@@ -0,0 +1,425 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// RefusalRule describes when a trait makes a pet refuse an action
+type RefusalRule struct {
+	Action    string // Interaction type ("feed", "play", "train", ...) or "eat_<food type>"
+	Message   string
+	Condition func(p *Pet) bool
+}
+
+// TraitDefinition describes a personality trait and how it shapes behavior
+type TraitDefinition struct {
+	Name        string
+	Category    string
+	Rare        bool
+	Modifiers   map[string]float64 // stat_name -> multiplier
+	EventChance map[string]float64 // event type -> chance multiplier
+	MoodWeights map[string]float64 // mood -> weight multiplier for mood changes
+	Refusals    []RefusalRule
+}
+
+// Trait returns the persisted form of the definition
+func (d TraitDefinition) Trait() Trait {
+	modifiers := make(map[string]float64, len(d.Modifiers))
+	for key, value := range d.Modifiers {
+		modifiers[key] = value
+	}
+	return Trait{Name: d.Name, Category: d.Category, Modifiers: modifiers}
+}
+
+// GetTraitCategories returns every trait category in generation order
+func GetTraitCategories() []string {
+	return []string{
+		"temperament", "appetite", "sociability", "constitution",
+		"curiosity", "courage", "vocal", "sleepiness",
+	}
+}
+
+// GetTraitDefinitions returns every trait a pet can have. Within a category,
+// common traits come first and rare traits last.
+func GetTraitDefinitions() []TraitDefinition {
+	return []TraitDefinition{
+		// Temperament
+		{
+			Name:     "Calm",
+			Category: "temperament",
+			Modifiers: map[string]float64{
+				"energy_decay":    0.8,
+				"happiness_decay": 0.85,
+			},
+		},
+		{
+			Name:     "Hyperactive",
+			Category: "temperament",
+			Modifiers: map[string]float64{
+				"energy_decay": 1.3,
+				"play_bonus":   1.25,
+			},
+			EventChance: map[string]float64{EventZoomies: 2.0},
+			MoodWeights: map[string]float64{"playful": 1.5, "lazy": 0.5},
+		},
+		{
+			Name:        "Stubborn",
+			Category:    "temperament",
+			Rare:        true,
+			Modifiers:   map[string]float64{"happiness_decay": 0.9},
+			MoodWeights: map[string]float64{"lazy": 1.3},
+			Refusals: []RefusalRule{{
+				Action:    "train",
+				Message:   "😤 Refuses to train until you're closer!",
+				Condition: func(p *Pet) bool { return p.Bond < StubbornTrainBond },
+			}},
+		},
+		// Appetite
+		{
+			Name:     "Picky",
+			Category: "appetite",
+			Modifiers: map[string]float64{
+				"feed_bonus": 0.75,
+			},
+			Refusals: []RefusalRule{{
+				Action:    "eat_" + FoodVegetables,
+				Message:   "🙅 Won't touch vegetables!",
+				Condition: func(p *Pet) bool { return true },
+			}},
+		},
+		{
+			Name:     "Hungry",
+			Category: "appetite",
+			Modifiers: map[string]float64{
+				"hunger_decay": 1.2,
+				"feed_bonus":   1.25,
+			},
+		},
+		{
+			Name:     "Gourmet",
+			Category: "appetite",
+			Rare:     true,
+			Modifiers: map[string]float64{
+				"feed_bonus":           1.1,
+				"feed_bonus_happiness": 1.5,
+			},
+			Refusals: []RefusalRule{
+				{
+					Action:    "feed",
+					Message:   "🧐 Only eats when properly hungry!",
+					Condition: func(p *Pet) bool { return p.Hunger >= GourmetFeedHunger },
+				},
+				{
+					Action:    "eat_" + FoodKibble,
+					Message:   "🧐 Kibble? How pedestrian.",
+					Condition: func(p *Pet) bool { return true },
+				},
+			},
+		},
+		// Sociability
+		{
+			Name:     "Independent",
+			Category: "sociability",
+			Modifiers: map[string]float64{
+				"happiness_decay": 0.75,
+			},
+			EventChance: map[string]float64{EventCuddles: 0.5},
+			MoodWeights: map[string]float64{"needy": 0.5},
+		},
+		{
+			Name:     "Needy",
+			Category: "sociability",
+			Modifiers: map[string]float64{
+				"happiness_decay":      1.15,
+				"play_bonus":           1.2,
+				"feed_bonus_happiness": 1.3,
+			},
+			MoodWeights: map[string]float64{"needy": 1.5},
+		},
+		{
+			Name:        "Lap Cat",
+			Category:    "sociability",
+			Rare:        true,
+			Modifiers:   map[string]float64{"happiness_decay": 0.9},
+			EventChance: map[string]float64{EventCuddles: 2.5},
+			MoodWeights: map[string]float64{"needy": 1.5, "playful": 0.7},
+		},
+		// Constitution
+		{
+			Name:     "Robust",
+			Category: "constitution",
+			Modifiers: map[string]float64{
+				"illness_chance": 0.5,
+				"health_decay":   0.85,
+			},
+		},
+		{
+			Name:     "Fragile",
+			Category: "constitution",
+			Modifiers: map[string]float64{
+				"illness_chance": 1.8,
+				"health_decay":   1.2,
+			},
+		},
+		{
+			Name:     "Iron Stomach",
+			Category: "constitution",
+			Rare:     true,
+			Modifiers: map[string]float64{
+				"illness_chance": 0.3,
+			},
+			EventChance: map[string]float64{EventAteSomething: 0.5},
+		},
+		// Curiosity
+		{
+			Name:     "Curious",
+			Category: "curiosity",
+			EventChance: map[string]float64{
+				EventFoundSomething: 1.5,
+				EventChasing:        1.3,
+				EventAteSomething:   1.3,
+			},
+		},
+		{
+			Name:     "Cautious",
+			Category: "curiosity",
+			EventChance: map[string]float64{
+				EventFoundSomething: 0.6,
+				EventAteSomething:   0.5,
+			},
+		},
+		{
+			Name:     "Explorer",
+			Category: "curiosity",
+			Rare:     true,
+			EventChance: map[string]float64{
+				EventFoundSomething: 2.5,
+				EventChasing:        2.0,
+				EventDaydreaming:    1.5,
+			},
+			MoodWeights: map[string]float64{"playful": 1.3},
+		},
+		// Courage
+		{
+			Name:        "Brave",
+			Category:    "courage",
+			EventChance: map[string]float64{EventScared: 0.5, EventNightmare: 0.7},
+		},
+		{
+			Name:        "Timid",
+			Category:    "courage",
+			EventChance: map[string]float64{EventScared: 2.0, EventNightmare: 1.5},
+			MoodWeights: map[string]float64{"needy": 1.3},
+			Refusals: []RefusalRule{{
+				Action:    "train",
+				Message:   "😟 Too nervous to train right now...",
+				Condition: func(p *Pet) bool { return p.Happiness < TimidTrainHappiness },
+			}},
+		},
+		{
+			Name:        "Fearless",
+			Category:    "courage",
+			Rare:        true,
+			EventChance: map[string]float64{EventScared: 0, EventNightmare: 0.3},
+		},
+		// Vocal
+		{
+			Name:        "Chatty",
+			Category:    "vocal",
+			EventChance: map[string]float64{EventSinging: 2.0},
+		},
+		{
+			Name:        "Quiet",
+			Category:    "vocal",
+			EventChance: map[string]float64{EventSinging: 0.5},
+			MoodWeights: map[string]float64{"needy": 0.8},
+		},
+		{
+			Name:        "Songbird",
+			Category:    "vocal",
+			Rare:        true,
+			Modifiers:   map[string]float64{"happiness_decay": 0.9},
+			EventChance: map[string]float64{EventSinging: 4.0},
+		},
+		// Sleepiness
+		{
+			Name:        "Sleepyhead",
+			Category:    "sleepiness",
+			Modifiers:   map[string]float64{"energy_decay": 1.1},
+			MoodWeights: map[string]float64{"lazy": 1.5},
+			Refusals: []RefusalRule{{
+				Action:    "play",
+				Message:   "🥱 Too sleepy to play...",
+				Condition: func(p *Pet) bool { return p.Energy < SleepyheadPlayEnergy },
+			}},
+		},
+		{
+			Name:        "Restless",
+			Category:    "sleepiness",
+			Modifiers:   map[string]float64{"energy_decay": 0.9},
+			EventChance: map[string]float64{EventNightmare: 1.5},
+			MoodWeights: map[string]float64{"lazy": 0.6, "playful": 1.2},
+		},
+		// Earned through experience, never rolled at birth (see GetTraitDriftRules)
+		{
+			Name:        "Trusting",
+			Category:    "bond",
+			Modifiers:   map[string]float64{"happiness_decay": 0.9, "illness_chance": 0.9},
+			EventChance: map[string]float64{EventCuddles: 1.3, EventScared: 0.7},
+			MoodWeights: map[string]float64{"playful": 1.2, "needy": 0.8},
+		},
+	}
+}
+
+// GetTraitDefinition returns the definition for a trait name
+func GetTraitDefinition(name string) *TraitDefinition {
+	for _, def := range GetTraitDefinitions() {
+		if def.Name == name {
+			return &def
+		}
+	}
+	return nil
+}
+
+// GetTraitsInCategory returns the definitions in a category, common traits first
+func GetTraitsInCategory(category string) []TraitDefinition {
+	var defs []TraitDefinition
+	for _, def := range GetTraitDefinitions() {
+		if def.Category == category {
+			defs = append(defs, def)
+		}
+	}
+	return defs
+}
+
+// traitWeight returns the relative chance of rolling a trait within its category
+func traitWeight(def TraitDefinition) float64 {
+	if def.Rare {
+		return RareTraitWeight
+	}
+	return 1.0
+}
+
+// GenerateTraits assigns one random personality trait per category at birth
+func GenerateTraits() []Trait {
+	var traits []Trait
+	for _, category := range GetTraitCategories() {
+		traits = append(traits, rollTrait(category))
+	}
+	return traits
+}
+
+// rollTrait picks a random trait from a category, rare traits less often
+func rollTrait(category string) Trait {
+	options := GetTraitsInCategory(category)
+
+	total := 0.0
+	for _, def := range options {
+		total += traitWeight(def)
+	}
+
+	roll := RandFloat64() * total
+	selected := options[len(options)-1]
+	cumulative := 0.0
+	for _, def := range options {
+		cumulative += traitWeight(def)
+		if roll < cumulative {
+			selected = def
+			break
+		}
+	}
+
+	log.Printf("Assigned %s trait: %s", selected.Category, selected.Name)
+	return selected.Trait()
+}
+
+// migrateTraits rolls a trait for each category added since an older save was made, so
+// existing pets pick up the newer behaviors too. Pets without any traits are left alone.
+func (p *Pet) migrateTraits() {
+	if len(p.Traits) == 0 {
+		return
+	}
+	has := make(map[string]bool)
+	for _, trait := range p.Traits {
+		has[trait.Category] = true
+	}
+	for _, category := range GetTraitCategories() {
+		if !has[category] {
+			p.Traits = append(p.Traits, rollTrait(category))
+		}
+	}
+}
+
+// traitDefinitions returns the registry definitions for the pet's traits
+func (p *Pet) traitDefinitions() []TraitDefinition {
+	var defs []TraitDefinition
+	for _, trait := range p.Traits {
+		if def := GetTraitDefinition(trait.Name); def != nil {
+			defs = append(defs, *def)
+		}
+	}
+	return defs
+}
+
+// GetEventChanceModifier returns the combined trait multiplier for an event's chance
+func (p *Pet) GetEventChanceModifier(eventType string) float64 {
+	multiplier := 1.0
+	for _, def := range p.traitDefinitions() {
+		if mod, exists := def.EventChance[eventType]; exists {
+			multiplier *= mod
+		}
+	}
+	return multiplier
+}
+
+// GetMoodWeightModifier returns the combined trait multiplier for a mood's weight
+func (p *Pet) GetMoodWeightModifier(mood string) float64 {
+	multiplier := 1.0
+	for _, def := range p.traitDefinitions() {
+		if mod, exists := def.MoodWeights[mood]; exists {
+			multiplier *= mod
+		}
+	}
+	return multiplier
+}
+
+// CheckTraitRefusal returns a message if one of the pet's traits makes it refuse an action
+func (p *Pet) CheckTraitRefusal(action string) (string, bool) {
+	for _, def := range p.traitDefinitions() {
+		for _, rule := range def.Refusals {
+			if rule.Action == action && rule.Condition(p) {
+				log.Printf("%s trait refused %s", def.Name, action)
+				return rule.Message, true
+			}
+		}
+	}
+	return "", false
+}
+
+// HasTrait reports whether the pet has a trait with the given name
+func (p *Pet) HasTrait(name string) bool {
+	for _, trait := range p.Traits {
+		if trait.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTraitsDisplay returns the pet's trait names, marking rare traits with a sparkle
+func GetTraitsDisplay(p Pet) string {
+	var names []string
+	for _, trait := range p.Traits {
+		name := trait.Name
+		if def := GetTraitDefinition(trait.Name); def != nil && def.Rare {
+			name = fmt.Sprintf("%s✨", name)
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return "None"
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,284 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Trick records a skill the pet has started learning
+type Trick struct {
+	Name          string    `json:"name"`
+	Proficiency   int       `json:"proficiency"` // 0-100
+	LearnedAt     time.Time `json:"learned_at"`
+	LastPracticed time.Time `json:"last_practiced"`
+}
+
+// TrickDefinition describes a trick that can be taught
+type TrickDefinition struct {
+	Name       string
+	Emoji      string
+	Difficulty float64 // Divides progress per session (1.0 = normal)
+	MinBond    int     // Bond required before the pet will attempt it
+}
+
+// GetTrickDefinitions returns all teachable tricks in learning order
+func GetTrickDefinitions() []TrickDefinition {
+	return []TrickDefinition{
+		{Name: "Sit", Emoji: "🐾", Difficulty: 1.0, MinBond: 0},
+		{Name: "Paw", Emoji: "🤝", Difficulty: 1.0, MinBond: 30},
+		{Name: "Spin", Emoji: "🌀", Difficulty: 1.25, MinBond: 40},
+		{Name: "Roll Over", Emoji: "🔄", Difficulty: 1.5, MinBond: 50},
+		{Name: "Play Dead", Emoji: "💫", Difficulty: 1.75, MinBond: 60},
+		{Name: "High Five", Emoji: "✋", Difficulty: 2.0, MinBond: 75},
+	}
+}
+
+// GetTrickDefinition returns the definition for a trick by name
+func GetTrickDefinition(name string) *TrickDefinition {
+	for _, def := range GetTrickDefinitions() {
+		if def.Name == name {
+			return &def
+		}
+	}
+	return nil
+}
+
+// GetTrickLevel returns a display label for a proficiency value
+func GetTrickLevel(proficiency int) string {
+	switch {
+	case proficiency >= TrickMasteredThreshold:
+		return "Mastered"
+	case proficiency >= TrickCompetentThreshold:
+		return "Competent"
+	default:
+		return "Learning"
+	}
+}
+
+// getTrainingMoodModifier returns how receptive the pet is to training in its current mood
+func getTrainingMoodModifier(mood string) float64 {
+	switch mood {
+	case "playful":
+		return 1.25
+	case "needy":
+		return 0.8
+	case "lazy":
+		return 0.6
+	default:
+		return 1.0
+	}
+}
+
+// findTrick returns the pet's record for a trick, or nil if it hasn't started learning it
+func (p *Pet) findTrick(name string) *Trick {
+	for i := range p.Tricks {
+		if p.Tricks[i].Name == name {
+			return &p.Tricks[i]
+		}
+	}
+	return nil
+}
+
+// nextTrickToTrain picks the first unmastered trick the pet is willing to work on
+func (p *Pet) nextTrickToTrain() *TrickDefinition {
+	for _, def := range GetTrickDefinitions() {
+		trick := p.findTrick(def.Name)
+		if trick != nil && trick.Proficiency >= TrickMasteredThreshold {
+			continue
+		}
+		if trick == nil && p.Bond < def.MinBond {
+			continue
+		}
+		return &def
+	}
+	return nil
+}
+
+// CountMasteredTricks returns how many tricks the pet has mastered
+func (p *Pet) CountMasteredTricks() int {
+	count := 0
+	for _, trick := range p.Tricks {
+		if trick.Proficiency >= TrickMasteredThreshold {
+			count++
+		}
+	}
+	return count
+}
+
+// Train runs a training session. Returns a message and whether the session took place.
+func (p *Pet) Train() (string, bool) {
+	if p.Dead {
+		return "", false
+	}
+	if p.Sleeping {
+		return fmt.Sprintf("%s Too sleepy to train...", StatusEmojiSleeping), false
+	}
+	if p.Energy < TrainMinEnergy {
+		return fmt.Sprintf("%s Too tired to train...", StatusEmojiTired), false
+	}
+	if p.Mood == "lazy" && p.Energy < 50 {
+		return "😪 Not in the mood to learn...", false
+	}
+	if msg, refused := p.CheckTraitRefusal("train"); refused {
+		return msg, false
+	}
+
+	def := p.nextTrickToTrain()
+	if def == nil {
+		if len(p.Tricks) == len(GetTrickDefinitions()) {
+			return "🎓 Every trick is already mastered!", false
+		}
+		return "🤍 Not ready to learn anything new yet (needs a stronger bond)", false
+	}
+
+	recentSessions := CountRecentInteractions(p.LastInteractions, "train", SpamPreventionWindow)
+	effectiveness := 1.0
+	if recentSessions > 0 {
+		effectiveness = 1.0 / float64(recentSessions+1)
+	}
+
+	moodModifier := getTrainingMoodModifier(p.Mood)
+	bondMultiplier := p.GetBondMultiplier()
+
+	p.Energy = max(p.Energy-TrainEnergyCost, MinStat)
+	p.Hunger = max(p.Hunger-TrainHungerCost, MinStat)
+	p.AddInteraction("train")
+
+	successChance := TrainBaseSuccessChance * bondMultiplier * moodModifier
+	if RandFloat64() >= successChance {
+		log.Printf("Training session for %s failed (chance: %.2f)", def.Name, successChance)
+		return fmt.Sprintf("🤔 Didn't quite get %s this time...", def.Name), true
+	}
+
+	gain := int(float64(TrainProgressGain) * moodModifier * effectiveness / def.Difficulty)
+	if gain < 1 {
+		gain = 1
+	}
+
+	now := TimeNow()
+	trick := p.findTrick(def.Name)
+	isNew := trick == nil
+	if isNew {
+		p.Tricks = append(p.Tricks, Trick{Name: def.Name, LearnedAt: now})
+		trick = &p.Tricks[len(p.Tricks)-1]
+	}
+	wasMastered := trick.Proficiency >= TrickMasteredThreshold
+	trick.Proficiency = min(trick.Proficiency+gain, MaxStat)
+	trick.LastPracticed = now
+	justMastered := !wasMastered && trick.Proficiency >= TrickMasteredThreshold
+
+	if recentSessions == 0 {
+		p.UpdateBond(BondGainNormal)
+	}
+
+	log.Printf("Trained %s (+%d, effectiveness: %.2f, mood mult: %.2f). Proficiency is now %d",
+		def.Name, gain, effectiveness, moodModifier, trick.Proficiency)
+
+	switch {
+	case isNew:
+		p.announceTrick(def.Name)
+		return fmt.Sprintf("%s Started learning %s!", def.Emoji, def.Name), true
+	case justMastered:
+		p.announceTrick(def.Name)
+		return fmt.Sprintf("%s Mastered %s!", def.Emoji, def.Name), true
+	default:
+		return fmt.Sprintf("%s Practiced %s (%d%%)", def.Emoji, def.Name, trick.Proficiency), true
+	}
+}
+
+// announceTrick raises a learned-trick event about the named trick so the player can see the progress
+func (p *Pet) announceTrick(name string) {
+	if p.CurrentEvent != nil && !p.CurrentEvent.Responded {
+		return
+	}
+	def := GetEventDefinition(EventLearnedTrick)
+	if def == nil {
+		return
+	}
+	now := TimeNow()
+	p.CurrentEvent = &Event{
+		Type:      EventLearnedTrick,
+		StartTime: now,
+		ExpiresAt: now.Add(def.Duration),
+		Trick:     name,
+	}
+}
+
+// BestTrick returns the trick the pet knows best, or nil if it knows none
+func (p *Pet) BestTrick() *Trick {
+	var best *Trick
+	for i := range p.Tricks {
+		if best == nil || p.Tricks[i].Proficiency > best.Proficiency {
+			best = &p.Tricks[i]
+		}
+	}
+	return best
+}
+
+// PerformTrick has the pet show off a trick for happiness. Returns a message and whether it performed.
+func (p *Pet) PerformTrick() (string, bool) {
+	if p.Dead {
+		return "", false
+	}
+	if len(p.Tricks) == 0 {
+		return "🤷 Doesn't know any tricks yet - try training!", false
+	}
+	if p.Sleeping {
+		return fmt.Sprintf("%s Too sleepy to perform...", StatusEmojiSleeping), false
+	}
+	if p.Energy < PerformEnergyDecrease {
+		return fmt.Sprintf("%s Too tired to perform...", StatusEmojiTired), false
+	}
+
+	index := int(RandFloat64() * float64(len(p.Tricks)))
+	if index >= len(p.Tricks) {
+		index = len(p.Tricks) - 1
+	}
+	trick := &p.Tricks[index]
+
+	recentPerforms := CountRecentInteractions(p.LastInteractions, "perform", SpamPreventionWindow)
+	effectiveness := 1.0
+	if recentPerforms > 0 {
+		effectiveness = 1.0 / float64(recentPerforms+1)
+	}
+
+	// Half the reward for a shaky trick, full reward once mastered
+	skill := 0.5 + 0.5*float64(min(trick.Proficiency, TrickMasteredThreshold))/float64(TrickMasteredThreshold)
+	happinessGain := int(float64(PerformHappinessIncrease) * skill * effectiveness * p.GetBondMultiplier())
+
+	p.Happiness = min(p.Happiness+happinessGain, MaxStat)
+	p.Energy = max(p.Energy-PerformEnergyDecrease, MinStat)
+	trick.Proficiency = min(trick.Proficiency+TrickPracticeGain, MaxStat)
+	trick.LastPracticed = TimeNow()
+	p.AddInteraction("perform")
+
+	log.Printf("Performed %s (proficiency %d, effectiveness: %.2f). Happiness is now %d",
+		trick.Name, trick.Proficiency, effectiveness, p.Happiness)
+
+	emoji := "🎓"
+	if def := GetTrickDefinition(trick.Name); def != nil {
+		emoji = def.Emoji
+	}
+	if trick.Proficiency < TrickCompetentThreshold {
+		return fmt.Sprintf("%s A wobbly %s... still cute! (+%d happiness)", emoji, trick.Name, happinessGain), true
+	}
+	return fmt.Sprintf("%s Perfect %s! (+%d happiness)", emoji, trick.Name, happinessGain), true
+}
+
+// GetTricksDisplay returns a compact summary of learned tricks for stats views
+func GetTricksDisplay(p Pet) string {
+	if len(p.Tricks) == 0 {
+		return "None"
+	}
+	var parts []string
+	for _, trick := range p.Tricks {
+		if trick.Proficiency >= TrickMasteredThreshold {
+			parts = append(parts, trick.Name+" ★")
+		} else {
+			parts = append(parts, fmt.Sprintf("%s %d%%", trick.Name, trick.Proficiency))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
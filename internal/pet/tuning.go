@@ -0,0 +1,75 @@
+package pet
+
+import (
+	"encoding/json"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+// Tuning holds balance values that can be adjusted without rebuilding. Any field left out
+// of tuning.json keeps its default.
+type Tuning struct {
+	Economy EconomyTuning `json:"economy"`
+}
+
+// EconomyTuning sets how coins are earned and what they buy
+type EconomyTuning struct {
+	EventReward      int            `json:"event_reward"`       // Coins for responding to an event
+	QuestReward      int            `json:"quest_reward"`       // Coins for each completed quest
+	StreakBonus      int            `json:"streak_bonus"`       // Coins for each streak milestone
+	ChaseCatchReward int            `json:"chase_catch_reward"` // Coins for a catch in chase mode
+	ChaseDailyCap    int            `json:"chase_daily_cap"`    // Chase catches rewarded per day
+	Prices           map[string]int `json:"prices"`             // Shop item ID -> price
+	ToyPlayBonus     float64        `json:"toy_play_bonus"`     // Happiness multiplier when playing with an owned toy
+	VitaminsHealth   int            `json:"vitamins_health"`    // Health restored by vitamins
+}
+
+// DefaultTuning returns the built-in balance values
+func DefaultTuning() Tuning {
+	return Tuning{
+		Economy: EconomyTuning{
+			EventReward:      5,
+			QuestReward:      10,
+			StreakBonus:      25,
+			ChaseCatchReward: 15,
+			ChaseDailyCap:    3,
+			Prices: map[string]int{
+				FoodKibble:     4,
+				FoodTreat:      8,
+				FoodFish:       12,
+				FoodVegetables: 6,
+				ShopBall:       30,
+				ShopRope:       30,
+				ShopPuzzleBox:  45,
+				ShopLaser:      45,
+				ShopVitamins:   20,
+				ShopBow:        40,
+				ShopSunglasses: 60,
+				ShopTopHat:     80,
+				ShopCrown:      150,
+			},
+			ToyPlayBonus:   1.25,
+			VitaminsHealth: 20,
+		},
+	}
+}
+
+// GetTuningPath returns the path to the tuning file, next to the pet state file
+func GetTuningPath() string {
+	return filepath.Join(filepath.Dir(GetConfigPath()), "tuning.json")
+}
+
+// GetTuning returns the defaults overlaid with anything set in tuning.json
+func GetTuning() Tuning {
+	t := DefaultTuning()
+	data, err := os.ReadFile(GetTuningPath())
+	if err != nil {
+		return t
+	}
+	if err := json.Unmarshal(data, &t); err != nil {
+		log.Printf("Error loading tuning: %v", err)
+		return DefaultTuning()
+	}
+	return t
+}
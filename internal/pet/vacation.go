@@ -0,0 +1,186 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// Vacation records a stay with a pet sitter while the owner is away
+type Vacation struct {
+	Start  time.Time `json:"start"`
+	Until  time.Time `json:"until"`
+	Before StatCheck `json:"before"` // Stats when the owner left, for the return summary
+	Bond   int       `json:"bond"`
+}
+
+// VacationSpan records when a finished trip started and ended
+type VacationSpan struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// overlapHours returns how many hours two time ranges share
+func overlapHours(aStart, aEnd, bStart, bEnd time.Time) float64 {
+	start := aStart
+	if bStart.After(start) {
+		start = bStart
+	}
+	end := aEnd
+	if bEnd.Before(end) {
+		end = bEnd
+	}
+	if !end.After(start) {
+		return 0
+	}
+	return end.Sub(start).Hours()
+}
+
+// OnVacation reports whether the pet is with its sitter at the given moment
+func (p *Pet) OnVacation(t time.Time) bool {
+	return p.Vacation != nil && !t.Before(p.Vacation.Start) && t.Before(p.Vacation.Until)
+}
+
+// vacationSpans returns every recent trip, including the one in progress
+func (p *Pet) vacationSpans() []VacationSpan {
+	spans := append([]VacationSpan(nil), p.PastVacations...)
+	if p.Vacation != nil {
+		spans = append(spans, VacationSpan{Start: p.Vacation.Start, End: p.Vacation.Until})
+	}
+	return spans
+}
+
+// VacationOverlapHours returns how much of the given span the pet spent with its sitter
+func (p *Pet) VacationOverlapHours(from, to time.Time) float64 {
+	total := 0.0
+	for _, span := range p.vacationSpans() {
+		total += overlapHours(from, to, span.Start, span.End)
+	}
+	return total
+}
+
+// PausedHours returns the total time that hasn't counted toward the pet's age
+func (p *Pet) PausedHours(now time.Time) float64 {
+	paused := p.VacationHours
+	if p.Vacation != nil {
+		paused += p.VacationOverlapHours(p.Vacation.Start, now)
+	}
+	return paused
+}
+
+// AgeAt returns the pet's age in hours, not counting time spent on vacation
+func (p *Pet) AgeAt(now time.Time) int {
+	if len(p.Logs) == 0 {
+		return p.Age
+	}
+	return int(now.Sub(p.Logs[0].Time).Hours() - p.PausedHours(now))
+}
+
+// ParseReturnDate reads a YYYY-MM-DD date in the given zone. The owner is away for all of that
+// day, so the trip ends at the midnight after it.
+func ParseReturnDate(date string, loc *time.Location) (time.Time, error) {
+	day, err := time.ParseInLocation("2006-01-02", date, loc)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return day.AddDate(0, 0, 1), nil
+}
+
+// StartVacation leaves the pet with a sitter until the given time. Trips are capped in length,
+// need a cooldown between them, and can't be used to rescue a pet that's already in danger.
+func (p *Pet) StartVacation(until time.Time) (string, error) {
+	now := TimeNow()
+	switch {
+	case p.Dead:
+		return "", fmt.Errorf("%s has passed away", p.Name)
+	case p.Vacation != nil:
+		return "", fmt.Errorf("%s is already with a sitter until %s", p.Name, p.LocalTime(p.Vacation.Until).Format("Jan 2"))
+	case !until.After(now):
+		return "", fmt.Errorf("return date must be in the future")
+	case until.Sub(now) > MaxVacationDuration:
+		return "", fmt.Errorf("sitters only take pets for up to %d days", int(MaxVacationDuration.Hours()/24))
+	case p.CriticalStartTime != nil:
+		return "", fmt.Errorf("%s is too unwell to leave with a sitter", p.Name)
+	}
+	if p.LastVacationEnd != nil {
+		if wait := VacationCooldown - now.Sub(*p.LastVacationEnd); wait > 0 {
+			return "", fmt.Errorf("%s needs time at home first; next vacation in %s", p.Name, formatDuration(wait))
+		}
+	}
+
+	p.Vacation = &Vacation{Start: now, Until: until, Before: p.currentStats(), Bond: p.Bond}
+	log.Printf("Started vacation until %s", until)
+	return fmt.Sprintf("%s %s is staying with a pet sitter until %s. Have a good trip!",
+		StatusEmojiVacation, p.Name, p.LocalTime(until).Format("Mon Jan 2")), nil
+}
+
+// EndVacation brings the pet home, banking the paused time and leaving a summary to show the owner
+func (p *Pet) EndVacation(now time.Time) string {
+	if p.Vacation == nil {
+		return ""
+	}
+	away := p.VacationOverlapHours(p.Vacation.Start, now)
+	p.VacationHours += away
+
+	before := p.Vacation.Before
+	after := p.currentStats()
+	changes := []string{
+		fmt.Sprintf("Hunger %d→%d", before.Hunger, after.Hunger),
+		fmt.Sprintf("Happiness %d→%d", before.Happiness, after.Happiness),
+		fmt.Sprintf("Energy %d→%d", before.Energy, after.Energy),
+		fmt.Sprintf("Health %d→%d", before.Health, after.Health),
+		fmt.Sprintf("Clean %d→%d", before.Cleanliness, after.Cleanliness),
+	}
+	summary := fmt.Sprintf("%s Welcome back! %s spent %s with the sitter. %s. Bond held at %d, and aging was paused.",
+		StatusEmojiVacation, p.Name, formatDuration(time.Duration(away*float64(time.Hour))), strings.Join(changes, ", "), p.Bond)
+
+	// Keep the trip so later loads still skip it when measuring neglect
+	end := now
+	if p.Vacation.Until.Before(end) {
+		end = p.Vacation.Until
+	}
+	p.PastVacations = append(p.PastVacations, VacationSpan{Start: p.Vacation.Start, End: end})
+	if len(p.PastVacations) > MaxVacationHistory {
+		p.PastVacations = p.PastVacations[len(p.PastVacations)-MaxVacationHistory:]
+	}
+
+	p.LastVacationEnd = &now
+	p.Vacation = nil
+	p.VacationSummary = summary
+	log.Printf("Ended vacation after %.1f hours", away)
+	return summary
+}
+
+// applySitterCare tops up any stat that fell below what the sitter maintains
+func (p *Pet) applySitterCare() {
+	p.Hunger = max(p.Hunger, VacationStatFloor)
+	p.Happiness = max(p.Happiness, VacationStatFloor)
+	p.Energy = max(p.Energy, VacationStatFloor)
+	p.Health = max(p.Health, VacationStatFloor)
+	p.Cleanliness = max(p.Cleanliness, VacationStatFloor)
+}
+
+// TakeVacationSummary returns the pending return summary and clears it
+func (p *Pet) TakeVacationSummary() string {
+	summary := p.VacationSummary
+	p.VacationSummary = ""
+	return summary
+}
+
+// GetVacationDisplay describes the pet's vacation status, or "" when it's home
+func GetVacationDisplay(p Pet) string {
+	if p.Vacation == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s With a sitter until %s", StatusEmojiVacation, p.LocalTime(p.Vacation.Until).Format("Mon Jan 2 15:04"))
+}
+
+// formatDuration renders a duration as days and hours, e.g. "3d 4h"
+func formatDuration(d time.Duration) string {
+	hours := int(d.Round(time.Hour).Hours())
+	if hours < 24 {
+		return fmt.Sprintf("%dh", hours)
+	}
+	return fmt.Sprintf("%dd %dh", hours/24, hours%24)
+}
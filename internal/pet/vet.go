@@ -0,0 +1,101 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+)
+
+// Checkup takes the pet to the vet, diagnosing any illness, even one still incubating.
+// Returns a message and whether the checkup happened.
+func (p *Pet) Checkup() (string, bool) {
+	if p.Dead {
+		return "", false
+	}
+	if CountRecentInteractions(p.LastInteractions, "checkup", CheckupCooldown) > 0 {
+		return "🩺 The vet saw them recently. Come back later!", false
+	}
+
+	p.ensureDisease()
+	p.AddInteraction("checkup")
+	if p.Disease == nil {
+		log.Printf("Checkup found nothing wrong")
+		return "🩺 Clean bill of health!", true
+	}
+
+	def := GetDiseaseDefinition(p.Disease.Type)
+	if def == nil {
+		p.CureDisease()
+		return "🩺 Clean bill of health!", true
+	}
+	if !p.Disease.Diagnosed {
+		p.Disease.Diagnosed = true
+		p.UpdateBond(BondGainNormal)
+	}
+	log.Printf("Checkup diagnosed %s", def.Name)
+	return fmt.Sprintf("🩺 Diagnosis: %s %s. Treat with %d doses, %dh apart",
+		def.Emoji, def.Name, def.Doses, int(def.DoseInterval.Hours())), true
+}
+
+// medicineAbuse applies the penalty for forcing medicine the pet didn't need
+func (p *Pet) medicineAbuse(reason string) string {
+	p.Health = max(p.Health-OverdoseHealthPenalty, MinStat)
+	p.Happiness = max(p.Happiness-OverdoseHappinessPenalty, MinStat)
+	p.UpdateBond(-MedicineAbuseBondLoss)
+	p.AddInteraction("medicine")
+	log.Printf("Medicine overdose: %s", reason)
+	return fmt.Sprintf("😵 %s (-%d health)", reason, OverdoseHealthPenalty)
+}
+
+// GiveMedicine gives the pet one dose of its treatment course. The illness must be
+// diagnosed first. Healthy pets refuse medicine; forcing more on them, or dosing before
+// the next dose is due, is an overdose. Returns a message and whether medicine was given.
+func (p *Pet) GiveMedicine() (string, bool) {
+	if p.Dead {
+		return "", false
+	}
+	p.ensureDisease()
+
+	if p.Disease == nil {
+		if CountRecentInteractions(p.LastInteractions, "medicine", MedicineAbuseWindow) > 0 {
+			return p.medicineAbuse("Forced medicine on a healthy pet! Overdose!"), true
+		}
+		p.AddInteraction("medicine")
+		return "😾 Spits out the pill. They aren't sick!", false
+	}
+	if !p.Disease.Diagnosed {
+		return "🩺 Something's wrong, but what? Get a checkup first", false
+	}
+
+	def := GetDiseaseDefinition(p.Disease.Type)
+	if def == nil {
+		// An illness this version doesn't know, e.g. from an older save, is cured by the first dose
+		p.CureDisease()
+		p.AddInteraction("medicine")
+		return "💊 Treatment complete! They're all better!", true
+	}
+	if CountRecentInteractions(p.LastInteractions, "medicine", def.DoseInterval) > 0 {
+		return p.medicineAbuse("Too soon for another dose! Overdose!"), true
+	}
+
+	bondMultiplier := p.GetBondMultiplier()
+	healthGain := int(float64(MedicineEffect) * bondMultiplier)
+	p.Health = min(p.Health+healthGain, MaxStat)
+	p.Disease.DosesGiven++
+	p.AddInteraction("medicine")
+
+	if p.Illness {
+		p.UpdateBond(BondGainWellTimed)
+		p.AddTraitExperience(ExperienceWellTimedCare)
+	} else {
+		p.UpdateBond(BondGainNormal)
+	}
+
+	log.Printf("Gave %s medicine dose %d/%d (bond mult: %.2f). Health is now %d",
+		def.Name, p.Disease.DosesGiven, def.Doses, bondMultiplier, p.Health)
+
+	if p.Disease.DosesGiven >= def.Doses {
+		p.CureDisease()
+		return fmt.Sprintf("💊 Treatment complete! %s is cured!", def.Name), true
+	}
+	return fmt.Sprintf("💊 %s dose %d/%d. Next dose in %dh", def.Name, p.Disease.DosesGiven, def.Doses, int(def.DoseInterval.Hours())), true
+}
@@ -0,0 +1,145 @@
+package pet
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Transaction is one entry in the wallet's log
+type Transaction struct {
+	Time    time.Time `json:"time"`
+	Amount  int       `json:"amount"` // Negative for spending
+	Reason  string    `json:"reason"`
+	Balance int       `json:"balance"` // Balance after the transaction
+}
+
+// Wallet holds the owner's coins and purchases, shared by every pet
+type Wallet struct {
+	Balance      int           `json:"balance"`
+	Transactions []Transaction `json:"transactions,omitempty"`
+	Cosmetics    []string      `json:"cosmetics,omitempty"`     // Owned cosmetic item IDs
+	ChaseDay     string        `json:"chase_day,omitempty"`     // Date of the last rewarded chase catch
+	ChaseRewards int           `json:"chase_rewards,omitempty"` // Chase catches rewarded on ChaseDay
+}
+
+// GetWalletPath returns the path to the wallet file, next to the pet state file
+func GetWalletPath() string {
+	return filepath.Join(filepath.Dir(GetConfigPath()), "wallet.json")
+}
+
+// LoadWallet loads the wallet, returning an empty one if none exists
+func LoadWallet() Wallet {
+	var w Wallet
+	data, err := os.ReadFile(GetWalletPath())
+	if err != nil {
+		return w
+	}
+	if err := json.Unmarshal(data, &w); err != nil {
+		log.Printf("Error loading wallet: %v", err)
+	}
+	return w
+}
+
+// SaveWallet writes the wallet to disk
+func SaveWallet(w Wallet) {
+	data, err := json.MarshalIndent(w, "", "  ")
+	if err != nil {
+		log.Printf("Error saving wallet: %v", err)
+		return
+	}
+	if err := os.WriteFile(GetWalletPath(), data, 0644); err != nil {
+		log.Printf("Error writing wallet: %v", err)
+	}
+}
+
+// record adds a transaction and updates the balance
+func (w *Wallet) record(t Transaction) {
+	w.Balance += t.Amount
+	t.Balance = w.Balance
+	w.Transactions = append(w.Transactions, t)
+	if len(w.Transactions) > MaxTransactionLog {
+		w.Transactions = w.Transactions[len(w.Transactions)-MaxTransactionLog:]
+	}
+}
+
+// Owns reports whether a cosmetic has been bought
+func (w Wallet) Owns(itemID string) bool {
+	for _, owned := range w.Cosmetics {
+		if owned == itemID {
+			return true
+		}
+	}
+	return false
+}
+
+// EarnCoins queues coins the pet earned; they reach the wallet when the pet is saved
+func (p *Pet) EarnCoins(amount int, reason string) {
+	if amount <= 0 {
+		return
+	}
+	p.PendingCoins = append(p.PendingCoins, Transaction{Time: TimeNow(), Amount: amount, Reason: reason})
+	log.Printf("Earned %d coins: %s", amount, reason)
+}
+
+// bankPending moves the pet's queued earnings into the wallet
+func (w *Wallet) bankPending(p *Pet) {
+	for _, t := range p.PendingCoins {
+		w.record(t)
+	}
+	p.PendingCoins = nil
+}
+
+// BankCoins deposits the pet's queued earnings into the saved wallet
+func BankCoins(p *Pet) {
+	if len(p.PendingCoins) == 0 {
+		return
+	}
+	w := LoadWallet()
+	w.bankPending(p)
+	SaveWallet(w)
+}
+
+// DepositCoins adds coins straight to the saved wallet, for earnings outside a pet's actions
+func DepositCoins(amount int, reason string) {
+	if amount <= 0 {
+		return
+	}
+	w := LoadWallet()
+	w.record(Transaction{Time: TimeNow(), Amount: amount, Reason: reason})
+	SaveWallet(w)
+}
+
+// RewardChaseCatch pays for a catch in chase mode and counts it toward achievements. Only the first few
+// catches of the pet's day are rewarded, and none once the pet has died. Returns the coins paid, 0 when the
+// catch went unrewarded, and any achievements unlocked.
+func RewardChaseCatch(p *Pet, target string) (int, []AchievementDefinition) {
+	if p.Dead {
+		return 0, nil
+	}
+	economy := GetTuning().Economy
+	now := TimeNow()
+	today := p.LocalTime(now).Format("2006-01-02")
+
+	w := LoadWallet()
+	if w.ChaseDay != today {
+		w.ChaseDay = today
+		w.ChaseRewards = 0
+	}
+	if w.ChaseRewards >= economy.ChaseDailyCap {
+		log.Printf("Chase reward cap of %d reached for %s", economy.ChaseDailyCap, today)
+		return 0, nil
+	}
+	w.ChaseRewards++
+	w.record(Transaction{Time: now, Amount: economy.ChaseCatchReward, Reason: "Caught a " + target})
+	SaveWallet(w)
+	return economy.ChaseCatchReward, RecordChaseCatch(p)
+}
+
+// GetCoinDisplay returns a balance with the coin emoji, e.g. "🪙 42"
+func GetCoinDisplay(balance int) string {
+	return fmt.Sprintf("%s %d", CoinEmoji, balance)
+}
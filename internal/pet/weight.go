@@ -0,0 +1,126 @@
+package pet
+
+import (
+	"fmt"
+	"log"
+)
+
+// Weight class constants
+const (
+	WeightUnderweight = "underweight"
+	WeightFit         = "fit"
+	WeightOverweight  = "overweight"
+)
+
+// WeightClassDefinition describes how a weight class affects the pet
+type WeightClassDefinition struct {
+	Class       string
+	Name        string
+	Emoji       string
+	Modifiers   map[string]float64 // stat_name -> multiplier
+	EventChance map[string]float64 // event type -> chance multiplier (0 makes the event unavailable)
+}
+
+// GetWeightClassDefinitions returns every weight class from lightest to heaviest
+func GetWeightClassDefinitions() []WeightClassDefinition {
+	return []WeightClassDefinition{
+		{
+			Class: WeightUnderweight,
+			Name:  "Underweight",
+			Emoji: "🦴",
+			Modifiers: map[string]float64{
+				"energy_decay":   1.2,
+				"illness_chance": 1.5,
+			},
+			EventChance: map[string]float64{
+				EventAteSomething: 2.0, // Scavenges for food
+				EventZoomies:      0,
+				EventSinging:      0.5,
+			},
+		},
+		{
+			Class: WeightFit,
+			Name:  "Fit",
+			Emoji: "💪",
+		},
+		{
+			Class: WeightOverweight,
+			Name:  "Overweight",
+			Emoji: "🍩",
+			Modifiers: map[string]float64{
+				"energy_decay":   1.3,
+				"illness_chance": 1.3,
+			},
+			EventChance: map[string]float64{
+				EventZoomies: 0,
+				EventChasing: 0.5,
+				EventCuddles: 1.3,
+			},
+		},
+	}
+}
+
+// GetWeightClass returns the pet's weight class
+func (p *Pet) GetWeightClass() WeightClassDefinition {
+	defs := GetWeightClassDefinitions()
+	switch {
+	case p.Weight <= UnderweightThreshold:
+		return defs[0]
+	case p.Weight >= OverweightThreshold:
+		return defs[2]
+	default:
+		return defs[1]
+	}
+}
+
+// GetWeightModifier returns the weight class multiplier for a stat
+func (p *Pet) GetWeightModifier(modifierKey string) float64 {
+	if mod, exists := p.GetWeightClass().Modifiers[modifierKey]; exists {
+		return mod
+	}
+	return 1.0
+}
+
+// GetWeightEventModifier returns the weight class multiplier for an event's chance
+func (p *Pet) GetWeightEventModifier(eventType string) float64 {
+	if mod, exists := p.GetWeightClass().EventChance[eventType]; exists {
+		return mod
+	}
+	return 1.0
+}
+
+// ChangeWeight adjusts the pet's weight within its limits, logging class changes
+func (p *Pet) ChangeWeight(change int) {
+	before := p.GetWeightClass().Class
+	p.Weight = max(min(p.Weight+change, MaxWeight), MinWeight)
+	if after := p.GetWeightClass(); after.Class != before {
+		log.Printf("Pet is now %s (weight %+d)", after.Name, p.Weight)
+	}
+}
+
+// UpdateWeight applies elapsed time to weight. Hungry pets lose weight; otherwise
+// metabolism slowly brings the pet back toward its ideal weight. Hours too few for a
+// whole point carry over, so frequent short updates add up like one long one.
+func (p *Pet) UpdateWeight(elapsedHours float64) {
+	p.WeightHours += elapsedHours
+	if p.Hunger < LowStatThreshold {
+		loss := int(p.WeightHours * StarvingWeightLoss)
+		p.WeightHours -= float64(loss) / StarvingWeightLoss
+		p.ChangeWeight(-loss)
+		return
+	}
+
+	steps := int(p.WeightHours / MetabolismHours)
+	p.WeightHours -= float64(steps * MetabolismHours)
+	if p.Weight > 0 {
+		p.ChangeWeight(-min(steps, p.Weight))
+	} else if p.Weight < 0 {
+		p.ChangeWeight(min(steps, -p.Weight))
+	}
+}
+
+// GetWeightDisplay returns the weight class with the distance from ideal weight
+func GetWeightDisplay(p Pet) string {
+	class := p.GetWeightClass()
+	return fmt.Sprintf("%s %s (%+d)", class.Emoji, class.Name, p.Weight)
+}
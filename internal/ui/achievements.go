@@ -0,0 +1,35 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"vpet/internal/pet"
+)
+
+// FormatAchievements renders every achievement, unlocked or not, as plain text for the CLI, with dates in the given zone
+func FormatAchievements(a pet.Achievements, loc *time.Location) string {
+	definitions := pet.GetAchievementDefinitions()
+
+	var s strings.Builder
+	s.WriteString(fmt.Sprintf("🏆 Achievements (%d/%d)\n\n", len(a.Unlocked), len(definitions)))
+	for _, def := range definitions {
+		unlocked := false
+		for _, record := range a.Unlocked {
+			if record.ID != def.ID {
+				continue
+			}
+			unlocked = true
+			s.WriteString(fmt.Sprintf("%s %s - %s\n", def.Emoji, def.Name, def.Description))
+			s.WriteString(fmt.Sprintf("   Unlocked %s by %s\n", record.Time.In(loc).Format("Jan 2 2006"), record.PetName))
+		}
+		if !unlocked {
+			s.WriteString(fmt.Sprintf("🔒 %s - %s\n", def.Name, def.Description))
+		}
+	}
+	if a.ChaseCatches > 0 {
+		s.WriteString(fmt.Sprintf("\nChase catches: %d\n", a.ChaseCatches))
+	}
+	return s.String()
+}
@@ -0,0 +1,78 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vpet/internal/pet"
+)
+
+func TestAchievementAnnouncedInMessageArea(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	p := pet.NewPet(nil)
+	p.Traits = nil
+	p.Bond = pet.SoulmatesBond
+	m := Model{Pet: p}
+
+	m.modifyStats(func(p *pet.Pet) {})
+	if !strings.Contains(m.Announcement, "Soulmates") {
+		t.Fatalf("Expected Soulmates to be announced, got %q", m.Announcement)
+	}
+	if !strings.Contains(m.View(), "Achievement unlocked") {
+		t.Error("Expected the announcement in the view")
+	}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = updated.(Model)
+	if m.Announcement != "" {
+		t.Error("Expected any key to dismiss the announcement")
+	}
+
+	m.modifyStats(func(p *pet.Pet) {})
+	if m.Announcement != "" {
+		t.Errorf("Expected an achievement to be announced only once, got %q", m.Announcement)
+	}
+}
+
+func TestFormatAchievements(t *testing.T) {
+	a := pet.Achievements{
+		Unlocked:     []pet.UnlockedAchievement{{ID: pet.AchievementFirstEvolution, Time: pet.TimeNow(), PetName: "Mochi"}},
+		ChaseCatches: 3,
+	}
+	output := FormatAchievements(a, time.UTC)
+
+	for _, want := range []string{"(1/", "🐣 First Steps", "by Mochi", "🔒 Soulmates", "Chase catches: 3"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in achievements list:\n%s", want, output)
+		}
+	}
+}
+
+func TestQuestCompletionAnnounced(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	p := pet.NewPet(nil)
+	p.Traits = nil
+	p.Cleanliness = 20
+	p.Quests = &pet.DailyQuests{
+		Day:    p.LocalTime(pet.TimeNow()).Format("2006-01-02"),
+		Quests: []pet.Quest{{Type: pet.QuestBath}},
+	}
+	m := Model{Pet: p, Choice: 4}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // Clean
+	m = updated.(Model)
+	if !strings.Contains(m.Announcement, "Quest complete: Give a bath") {
+		t.Errorf("Expected the quest completion to be announced, got %q", m.Announcement)
+	}
+	if !strings.Contains(pet.GetQuestSummary(m.Pet), "✅") {
+		t.Errorf("Expected the quest to show as done, got %q", pet.GetQuestSummary(m.Pet))
+	}
+}
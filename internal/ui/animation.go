@@ -1,6 +1,11 @@
 package ui
 
-import "time"
+import (
+	"strings"
+	"time"
+
+	"vpet/internal/pet"
+)
 
 // AnimationType represents the type of action animation
 type AnimationType int
@@ -11,6 +16,13 @@ const (
 	AnimPlay
 	AnimSleep
 	AnimMedicine
+	AnimTrain
+	AnimTrick
+	AnimEvolve
+	AnimTug
+	AnimPuzzle
+	AnimLaser
+	AnimClean
 )
 
 // Animation holds the current animation state
@@ -18,6 +30,9 @@ type Animation struct {
 	Type      AnimationType
 	Frame     int
 	StartTime time.Time
+	FromEmoji string // Substituted for {from} in frames (evolution)
+	ToEmoji   string // Substituted for {to} in frames (evolution)
+	Cosmetic  string // Equipped cosmetic, drawn above the pet
 }
 
 // AnimationFrames contains ASCII art frames for each animation type
@@ -100,23 +115,247 @@ var AnimationFrames = map[AnimationType][]string{
 		`
            😸
         ✨ +30 ✨
+`,
+	},
+	AnimTrain: {
+		`
+  🙋   😺
+`,
+		`
+  🙋   😺
+      "sit!"
+`,
+		`
+  🙋   🤔
+`,
+		`
+  🙋   😼
+      *tries*
+`,
+		`
+  🦴→  😸
+     good job!
+`,
+	},
+	AnimTrick: {
+		`
+     😺
+`,
+		`
+     😼
+     🌀
+`,
+		`
+   ✨😸✨
+`,
+		`
+     😻
+  👏 ta-da! 👏
+`,
+	},
+	AnimEvolve: {
+		`
+
+      {from}
+
+`,
+		`
+       ✨
+      {from}
+
+`,
+		`
+     ✨  ✨
+      {from}
+       ✨
+`,
+		`
+    ✨ ✨ ✨
+   ✨ {from} ✨
+    ✨ ✨ ✨
+`,
+		`
+    ✨ ✨ ✨
+   ✨  ⚪  ✨
+    ✨ ✨ ✨
+`,
+		`
+   🌟 🌟 🌟
+  🌟   ⚪   🌟
+   🌟 🌟 🌟
+`,
+		`
+    ✨ ✨ ✨
+   ✨  ⚪  ✨
+    ✨ ✨ ✨
+`,
+		`
+    ✨ ✨ ✨
+   ✨ {to} ✨
+    ✨ ✨ ✨
+`,
+		`
+     ✨  ✨
+      {to}
+       ✨
+`,
+		`
+  🎉        🎉
+      {to}
+  *evolved!*
+`,
+		`
+  🎉        🎉
+      {to}
+  *evolved!*
+`,
+		`
+  🎉        🎉
+      {to}
+  *evolved!*
+`,
+	},
+	AnimTug: {
+		`
+  🙋~~🪢~~😺
+`,
+		`
+  🙋~~🪢~~~😼
+            *grrr*
+`,
+		`
+ 🙋~~~🪢~~😺
+`,
+		`
+  🙋~~🪢~~~😼
+         *tug tug!*
+`,
+		`
+  🙋   🪢😸
+      *won!*
+`,
+	},
+	AnimPuzzle: {
+		`
+     🧩  😺
+`,
+		`
+     🧩  🤔
+`,
+		`
+     🧩🐾😼
+      *click*
+`,
+		`
+     🍪  😸
+    *solved it!*
+`,
+	},
+	AnimLaser: {
+		`
+  🔦            😺
+`,
+		`
+  🔦 - - - 🔴   😼
+`,
+		`
+  🔦 - - - - - 🔴😼
+`,
+		`
+  🔦 - 🔴    💨😼
+`,
+		`
+  🔦   🔴😼
+     *pounce!*
+`,
+	},
+	AnimClean: {
+		`
+   💩  😿  💩
+`,
+		`
+   🧹💩 😺  💩
+`,
+		`
+      😺 🧽💩
+`,
+		`
+   🫧  😺  🫧
+`,
+		`
+   ✨  😸  ✨
+  *squeaky clean!*
 `,
 	},
 }
 
+// animationCaptions describe each animation beside the sprite when emoji are off
+var animationCaptions = map[AnimationType]string{
+	AnimFeed:     "*munch*",
+	AnimPlay:     "*catch!*",
+	AnimSleep:    "zzz...",
+	AnimMedicine: "*gulp*",
+	AnimTrain:    "*sit!*",
+	AnimTrick:    "ta-da!",
+	AnimEvolve:   "*evolved!*",
+	AnimTug:      "*tug tug!*",
+	AnimPuzzle:   "*click*",
+	AnimLaser:    "*pounce!*",
+	AnimClean:    "*squeaky clean!*",
+}
+
 // AnimationDuration is how long each frame displays
 const AnimationFrameDuration = 200 * time.Millisecond
 
+// playAnimations maps each play activity to its animation
+var playAnimations = map[string]AnimationType{
+	pet.PlayFetch:  AnimPlay,
+	pet.PlayTug:    AnimTug,
+	pet.PlayPuzzle: AnimPuzzle,
+	pet.PlayLaser:  AnimLaser,
+}
+
 // GetAnimationFrame returns the current frame for an animation
 func GetAnimationFrame(anim Animation) string {
 	frames := AnimationFrames[anim.Type]
 	if len(frames) == 0 {
 		return ""
 	}
-	if anim.Frame >= len(frames) {
-		return frames[len(frames)-1]
+	frame := frames[len(frames)-1]
+	if anim.Frame < len(frames) {
+		frame = frames[anim.Frame]
+	}
+	frame = dressFrame(frame, anim.Cosmetic)
+	return strings.NewReplacer("{from}", anim.FromEmoji, "{to}", anim.ToEmoji).Replace(frame)
+}
+
+// petFaces are the glyphs that stand for the pet in animation frames
+var petFaces = []string{"{from}", "{to}", "😺", "😸", "😼", "😻", "😿", "😋", "😪", "😴", "🤔"}
+
+// dressFrame draws a cosmetic on the row above the first pet in a frame. The frame is left as it is if
+// something already occupies that spot, so props are never overwritten.
+func dressFrame(frame, cosmetic string) string {
+	if cosmetic == "" {
+		return frame
+	}
+	lines := strings.Split(frame, "\n")
+	for i := 1; i < len(lines); i++ {
+		idx := -1
+		for _, face := range petFaces {
+			if j := strings.Index(lines[i], face); j >= 0 && (idx < 0 || j < idx) {
+				idx = j
+			}
+		}
+		if idx < 0 {
+			continue
+		}
+		col := pet.DisplayWidth(lines[i][:idx])
+		if width := pet.DisplayWidth(lines[i-1]); width <= col {
+			lines[i-1] += strings.Repeat(" ", col-width) + cosmetic
+		}
+		break
 	}
-	return frames[anim.Frame]
+	return strings.Join(lines, "\n")
 }
 
 // IsAnimationComplete returns true if the animation has finished
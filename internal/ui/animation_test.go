@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -20,6 +21,13 @@ func TestAnimationTypes(t *testing.T) {
 		{"Play animation has frames", AnimPlay, 4},
 		{"Sleep animation has frames", AnimSleep, 3},
 		{"Medicine animation has frames", AnimMedicine, 4},
+		{"Train animation has frames", AnimTrain, 4},
+		{"Trick animation has frames", AnimTrick, 3},
+		{"Evolve animation has frames", AnimEvolve, 8},
+		{"Tug animation has frames", AnimTug, 4},
+		{"Puzzle animation has frames", AnimPuzzle, 4},
+		{"Laser animation has frames", AnimLaser, 4},
+		{"Clean animation has frames", AnimClean, 4},
 	}
 
 	for _, tt := range tests {
@@ -113,7 +121,7 @@ func TestAnimationFrameDuration(t *testing.T) {
 }
 
 func TestAllAnimationsHaveContent(t *testing.T) {
-	animTypes := []AnimationType{AnimFeed, AnimPlay, AnimSleep, AnimMedicine}
+	animTypes := []AnimationType{AnimFeed, AnimPlay, AnimSleep, AnimMedicine, AnimTrain, AnimTrick, AnimEvolve, AnimTug, AnimPuzzle, AnimLaser, AnimClean}
 
 	for _, animType := range animTypes {
 		frames := AnimationFrames[animType]
@@ -192,3 +200,241 @@ func TestRenderAnimationSkipsExpiredMessage(t *testing.T) {
 		t.Fatalf("expected expired message to be omitted from animation view")
 	}
 }
+
+func TestEvolveAnimationShowsForms(t *testing.T) {
+	anim := Animation{
+		Type:      AnimEvolve,
+		StartTime: time.Now(),
+		FromEmoji: "🐣",
+		ToEmoji:   "😃",
+	}
+
+	first := GetAnimationFrame(anim)
+	anim.Frame = AnimationTotalFrames(AnimEvolve) - 1
+	last := GetAnimationFrame(anim)
+
+	if !strings.Contains(first, "🐣") {
+		t.Errorf("Expected first frame to show the old form, got %q", first)
+	}
+	if !strings.Contains(last, "😃") {
+		t.Errorf("Expected last frame to show the new form, got %q", last)
+	}
+	if strings.Contains(first+last, "{from}") || strings.Contains(first+last, "{to}") {
+		t.Error("Expected placeholders to be substituted")
+	}
+}
+
+func TestEvolutionCeremonyStartsForPendingEvolution(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	p := pet.NewPet(nil)
+	p.EvolutionHistory = []pet.EvolutionRecord{{From: pet.FormBaby, To: pet.FormHealthyChild, Stage: pet.StageChild}}
+	p.Form = pet.FormHealthyChild
+	m := Model{Pet: p}
+
+	if !m.announceEvolutions() {
+		t.Fatal("Expected ceremony to start")
+	}
+	if m.Animation.Type != AnimEvolve {
+		t.Errorf("Expected AnimEvolve, got %v", m.Animation.Type)
+	}
+	if !strings.Contains(m.EvolutionMessage, "Healthy Child") {
+		t.Errorf("Expected message to name the new form, got %q", m.EvolutionMessage)
+	}
+	if len(m.Pet.PendingEvolutions()) != 0 {
+		t.Error("Expected evolution to be marked announced")
+	}
+	if m.announceEvolutions() {
+		t.Error("Expected no second ceremony for the same evolution")
+	}
+}
+
+func TestFoodMenuFeedsSelectedFood(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	p := pet.NewPet(nil)
+	p.Hunger = 40
+	p.Traits = nil
+	m := Model{Pet: p}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // Feed
+	m = updated.(Model)
+	if m.SubMenu != SubMenuFood {
+		t.Fatal("Expected Feed to open the food menu")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown}) // Treat
+	m = updated.(Model)
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	if m.SubMenu != SubMenuNone || m.Animation.Type != AnimFeed || cmd == nil {
+		t.Fatalf("Expected feeding to close the menu and animate, got menu=%v anim=%v", m.SubMenu, m.Animation.Type)
+	}
+	if m.Pet.Inventory[pet.FoodTreat] != pet.GetFoodDefinition(pet.FoodTreat).DailyRation-1 {
+		t.Errorf("Expected a treat to be used, have %d", m.Pet.Inventory[pet.FoodTreat])
+	}
+}
+
+func TestPlayMenuPlaysSelectedActivity(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	p := pet.NewPet(nil)
+	p.Happiness = 40
+	p.Bond = pet.MaxBond
+	p.Traits = nil
+	m := Model{Pet: p, Choice: 1}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // Play
+	m = updated.(Model)
+	if m.SubMenu != SubMenuPlay {
+		t.Fatal("Expected Play to open the activity menu")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown}) // Tug of War
+	m = updated.(Model)
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	if m.SubMenu != SubMenuNone || m.Animation.Type != AnimTug || cmd == nil {
+		t.Fatalf("Expected tug of war to close the menu and animate, got menu=%v anim=%v", m.SubMenu, m.Animation.Type)
+	}
+	last := m.Pet.LastInteractions[len(m.Pet.LastInteractions)-1]
+	if last.Type != "play" || last.Activity != pet.PlayTug {
+		t.Errorf("Expected a tug of war play interaction, got %+v", last)
+	}
+}
+
+func TestPlayMenuKeepsLockedActivityOpen(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	p := pet.NewPet(nil)
+	p.Bond = 0
+	p.Traits = nil
+	m := Model{Pet: p, Choice: 1}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = updated.(Model)
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+
+	if m.Animation.Type != AnimNone {
+		t.Errorf("Expected no animation for a locked activity, got %v", m.Animation.Type)
+	}
+	if !strings.Contains(m.Message, "unlocks at") {
+		t.Errorf("Expected unlock hint, got %q", m.Message)
+	}
+}
+
+func TestCleanMenuCleansUp(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	p := pet.NewPet(nil)
+	p.Cleanliness = 20
+	p.Traits = nil
+	m := Model{Pet: p, Choice: 4}
+
+	if !strings.Contains(m.renderStatus(), pet.StatusEmojiDirty) {
+		t.Error("Expected mess to show in the status")
+	}
+
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // Clean
+	m = updated.(Model)
+	if m.Animation.Type != AnimClean || cmd == nil {
+		t.Fatalf("Expected cleaning to animate, got %v", m.Animation.Type)
+	}
+	if m.Pet.Cleanliness <= 20 {
+		t.Errorf("Expected cleanliness to improve, got %d", m.Pet.Cleanliness)
+	}
+}
+
+func TestMedicineFollowsTreatmentCourse(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	p := pet.NewPet(nil)
+	p.Traits = nil
+	p.CatchDisease(pet.DiseaseFever)
+	m := Model{Pet: p, Choice: 3}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // Vet
+	m = updated.(Model)
+	if m.SubMenu != SubMenuVet {
+		t.Fatal("Expected Vet to open the vet menu")
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // Checkup
+	m = updated.(Model)
+	if m.SubMenu != SubMenuVet || !strings.Contains(m.Message, "Fever") {
+		t.Fatalf("Expected checkup to diagnose and stay in the vet menu, got %q", m.Message)
+	}
+
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m = updated.(Model)
+	updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter}) // Give Medicine
+	m = updated.(Model)
+	if m.Animation.Type != AnimMedicine || cmd == nil {
+		t.Fatalf("Expected medicine to animate, got %v", m.Animation.Type)
+	}
+	if !m.Pet.Illness || !strings.Contains(m.Message, "dose 1/") {
+		t.Errorf("Expected the first dose of a course, got %q", m.Message)
+	}
+}
+
+func TestOpeningTUIEndsVacation(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	p := pet.NewPet(nil)
+	if _, err := p.StartVacation(pet.TimeNow().Add(72 * time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	m := Model{Pet: p}
+	m.welcomeBack()
+
+	if m.Pet.Vacation != nil {
+		t.Error("Expected opening the TUI to end the vacation")
+	}
+	if !strings.Contains(m.Message, "Welcome back") {
+		t.Errorf("Expected the return summary to show, got %q", m.Message)
+	}
+	if m.Pet.VacationSummary != "" {
+		t.Error("Expected the summary to be consumed once shown")
+	}
+}
+
+func TestCosmeticInAnimations(t *testing.T) {
+	anim := Animation{Type: AnimSleep, Frame: 0, Cosmetic: "🎩"}
+	frame := GetAnimationFrame(anim)
+	lines := strings.Split(frame, "\n")
+	if lines[0] != "     🎩" || lines[1] != "     😺" {
+		t.Errorf("Expected the hat directly above the pet, got:\n%s", frame)
+	}
+
+	// A prop already sits above the pet, so the hat is left off rather than drawn over it
+	anim = Animation{Type: AnimEvolve, Frame: 1, FromEmoji: "🐣", Cosmetic: "🎩"}
+	if frame := GetAnimationFrame(anim); strings.Contains(frame, "🎩") {
+		t.Errorf("Expected no hat when the row above is taken, got:\n%s", frame)
+	}
+
+	anim = Animation{Type: AnimEvolve, Frame: 0, FromEmoji: "🐣", Cosmetic: "👑"}
+	if frame := GetAnimationFrame(anim); !strings.Contains(frame, "      👑\n      🐣") {
+		t.Errorf("Expected the crown above the evolving form, got:\n%s", frame)
+	}
+}
+
+func TestTitleShowsCosmetic(t *testing.T) {
+	p := pet.NewPet(nil)
+	p.Cosmetic = pet.ShopBow
+	m := Model{Pet: p}
+	if title := m.renderTitle(); !strings.Contains(title, "🎀"+p.GetFormEmoji()+" "+p.Name) {
+		t.Errorf("Expected the bow beside the form emoji, got %q", title)
+	}
+}
@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"vpet/internal/pet"
+)
+
+// FormatMemorial renders the memorial as plain text for the CLI and TUI, with dates in the given zone
+func FormatMemorial(m pet.Memorial, loc *time.Location) string {
+	if len(m.Pets) == 0 {
+		return "🪦 The memorial is empty. May it stay that way.\n"
+	}
+
+	var s strings.Builder
+	records := m.Records()
+	s.WriteString("🪦 In Loving Memory\n\n")
+	s.WriteString(fmt.Sprintf("Pets remembered:  %d\n", records.TotalPets))
+	s.WriteString(fmt.Sprintf("Average lifespan: %d hours\n", records.AverageLifespan))
+	s.WriteString(fmt.Sprintf("Longest lived:    %s (%d hours)\n", records.LongestLived.Name, records.LongestLived.LifespanHours))
+	s.WriteString(fmt.Sprintf("Most bonded:      %s (%s)\n", records.MostBonded.Name, pet.GetBondDescription(records.MostBonded.Bond)))
+	s.WriteString(fmt.Sprintf("Most common end:  %s\n", records.MostCommonCause))
+
+	// Most recent first
+	for i := len(m.Pets) - 1; i >= 0; i-- {
+		entry := m.Pets[i]
+		form := pet.Pet{Form: entry.FinalForm}
+		s.WriteString("\n")
+		s.WriteString(fmt.Sprintf("💀 %s the %s\n", entry.Name, form.GetFormName()))
+		s.WriteString(fmt.Sprintf("   %s - %s (%d hours)\n",
+			entry.Born.In(loc).Format("Jan 2 2006"), entry.Died.In(loc).Format("Jan 2 2006"), entry.LifespanHours))
+		s.WriteString(fmt.Sprintf("   Cause:   %s\n", entry.CauseOfDeath))
+		if entry.Parent != "" {
+			s.WriteString(fmt.Sprintf("   Family:  Gen %d, heir of %s\n", entry.Generation, entry.Parent))
+		}
+		s.WriteString(fmt.Sprintf("   Lineage: %s\n", entry.GetLineageDisplay()))
+		if len(entry.Traits) > 0 {
+			s.WriteString(fmt.Sprintf("   Traits:  %s\n", strings.Join(entry.Traits, ", ")))
+		}
+		if entry.Chronotype != "" {
+			s.WriteString(fmt.Sprintf("   Type:    %s %s\n", pet.GetChronotypeEmoji(entry.Chronotype), pet.GetChronotypeName(entry.Chronotype)))
+		}
+		s.WriteString(fmt.Sprintf("   Bond:    %s\n", pet.GetBondDescription(entry.Bond)))
+		for _, event := range entry.NotableEvents {
+			s.WriteString(fmt.Sprintf("   • %s\n", event))
+		}
+	}
+	return s.String()
+}
+
+func (m Model) renderMemorial() string {
+	return lipgloss.JoinVertical(
+		lipgloss.Left,
+		gameStyles.title.Render("🪦 Memorial 🪦"),
+		"",
+		FormatMemorial(m.Memorial, m.Pet.Location()),
+		gameStyles.status.Render("Press any key to return"),
+	)
+}
@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"vpet/internal/pet"
+)
+
+func TestDeadViewShowsAgeAtDeath(t *testing.T) {
+	p := pet.NewPet(nil)
+	diedAt := pet.TimeNow()
+	p.Dead, p.DiedAt, p.AgeAtDeath, p.Age = true, &diedAt, 40, 90
+
+	view := Model{Pet: p, ShowingAdoptPrompt: true}.deadView()
+	if !strings.Contains(view, "They lived for 40 hours") {
+		t.Errorf("Expected the age at death, got:\n%s", view)
+	}
+}
+
+func TestFormatMemorial(t *testing.T) {
+	t.Run("Empty memorial", func(t *testing.T) {
+		if got := FormatMemorial(pet.Memorial{}, time.UTC); !strings.Contains(got, "empty") {
+			t.Errorf("Expected empty memorial message, got %q", got)
+		}
+	})
+
+	t.Run("Lists pets and records", func(t *testing.T) {
+		m := pet.Memorial{Pets: []pet.MemorialEntry{
+			{Name: "Milo", LifespanHours: 200, CauseOfDeath: "Old Age", Bond: 40, FinalForm: pet.FormWiseElder},
+			{Name: "Bean", LifespanHours: 20, CauseOfDeath: "Starvation", Bond: 95, NotableEvents: []string{"Mastered Sit"}},
+		}}
+		got := FormatMemorial(m, time.UTC)
+		for _, want := range []string{"Pets remembered:  2", "Longest lived:    Milo", "Most bonded:      Bean", "Milo the Wise Elder", "Mastered Sit"} {
+			if !strings.Contains(got, want) {
+				t.Errorf("Expected memorial to contain %q, got:\n%s", want, got)
+			}
+		}
+		if strings.Index(got, "Bean the") > strings.Index(got, "Milo the") {
+			t.Error("Expected most recent pet to be listed first")
+		}
+	})
+
+	t.Run("Dates follow the given zone", func(t *testing.T) {
+		died := time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC)
+		m := pet.Memorial{Pets: []pet.MemorialEntry{{Name: "Milo", Born: died.Add(-48 * time.Hour), Died: died}}}
+		tokyo := time.FixedZone("JST", 9*60*60)
+		if got := FormatMemorial(m, tokyo); !strings.Contains(got, "Jan 2 2024") {
+			t.Errorf("Expected the death date in the pet's zone, got:\n%s", got)
+		}
+	})
+}
+
+func TestCheatKillsSkipTheMemorial(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	m := Model{Pet: pet.NewPet(nil), CheatChoice: 14}
+	m.executeCheat()
+	if !m.Pet.Dead {
+		t.Fatal("Expected the cheat to kill the pet")
+	}
+	m.openMemorial()
+	if len(m.Memorial.Pets) != 0 {
+		t.Errorf("Expected a cheat kill to stay out of the memorial, got %+v", m.Memorial.Pets)
+	}
+}
@@ -3,6 +3,7 @@ package ui
 import (
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -10,6 +11,25 @@ import (
 	"vpet/internal/pet"
 )
 
+// SubMenu identifies an action's option list shown in place of the main menu
+type SubMenu int
+
+const (
+	SubMenuNone SubMenu = iota
+	SubMenuFood
+	SubMenuPlay
+	SubMenuVet
+	SubMenuShop
+)
+
+// Vet options, in menu order
+const (
+	VetCheckup  = "checkup"
+	VetMedicine = "medicine"
+)
+
+var vetOptions = []string{VetCheckup, VetMedicine}
+
 // Model represents the game state
 type Model struct {
 	Pet                pet.Pet
@@ -17,14 +37,21 @@ type Model struct {
 	Quitting           bool
 	ShowingAdoptPrompt bool
 	EvolutionMessage   string
+	Announcement       string // Quest and achievement news, shown until a key is pressed
 	Message            string
 	MessageExpires     time.Time
 	InCheatMenu        bool
 	CheatChoice        int
+	InMemorial         bool
+	SubMenu            SubMenu
+	SubMenuChoice      int
+	Memorial           pet.Memorial
 	Animation          Animation
+	Breath             int // Idle breathing frame for the sprite
 }
 
 type tickMsg time.Time
+type breathTickMsg time.Time
 type animTickMsg struct {
 	started time.Time
 }
@@ -32,16 +59,25 @@ type animTickMsg struct {
 // NewModel creates a new game model
 func NewModel() Model {
 	p := pet.LoadState()
-	return Model{
+	m := Model{
 		Pet:                p,
 		Choice:             0,
 		ShowingAdoptPrompt: p.Dead,
 	}
+	if !p.Dead {
+		m.welcomeBack()
+		m.announceEvolutions()
+		m.announceAchievements()
+	}
+	return m
 }
 
 // Init implements tea.Model
 func (m Model) Init() tea.Cmd {
-	return tick()
+	if m.Animation.Type != AnimNone {
+		return tea.Batch(tick(), breathTick(), animTick(m.Animation.StartTime))
+	}
+	return tea.Batch(tick(), breathTick())
 }
 
 func tick() tea.Cmd {
@@ -50,6 +86,12 @@ func tick() tea.Cmd {
 	})
 }
 
+func breathTick() tea.Cmd {
+	return tea.Tick(BreathInterval, func(t time.Time) tea.Msg {
+		return breathTickMsg(t)
+	})
+}
+
 func animTick(start time.Time) tea.Cmd {
 	return tea.Tick(AnimationFrameDuration, func(t time.Time) tea.Msg {
 		return animTickMsg{started: start}
@@ -97,10 +139,57 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// Handle sub-menu input
+		if m.SubMenu != SubMenuNone {
+			options := m.subMenuOptions()
+			switch msg.String() {
+			case "ctrl+c", "q":
+				m.Quitting = true
+				return m, tea.Quit
+			case "esc", "backspace":
+				m.SubMenu = SubMenuNone
+			case "up", "k":
+				if m.SubMenuChoice > 0 {
+					m.SubMenuChoice--
+				}
+			case "down", "j":
+				if m.SubMenuChoice < len(options) {
+					m.SubMenuChoice++
+				}
+			case "enter", " ":
+				if m.SubMenuChoice >= len(options) { // Back
+					m.SubMenu = SubMenuNone
+					return m, nil
+				}
+				if m.selectSubMenuOption(options[m.SubMenuChoice]) {
+					m.SubMenu = SubMenuNone
+					return m, animTick(m.Animation.StartTime)
+				}
+			}
+			return m, nil
+		}
+
+		// Any key closes the memorial
+		if m.InMemorial {
+			if msg.String() == "ctrl+c" {
+				m.Quitting = true
+				return m, tea.Quit
+			}
+			m.InMemorial = false
+			return m, nil
+		}
+
+		// Any key dismisses the evolution and other announcements
+		m.EvolutionMessage = ""
+		m.Announcement = ""
+
 		switch msg.String() {
 		case "ctrl+c", "q":
 			m.Quitting = true
 			return m, tea.Quit
+		case "m":
+			m.openMemorial()
+			return m, nil
 		case "c":
 			if !m.Pet.Dead {
 				m.InCheatMenu = true
@@ -109,12 +198,22 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		case "y":
 			if m.Pet.Dead && m.ShowingAdoptPrompt {
+				pet.ArchivePet(m.Pet)
 				m.Pet = pet.NewPet(nil)
 				m.ShowingAdoptPrompt = false
 				m.Choice = 0
 				pet.SaveState(&m.Pet)
 				return m, nil
 			}
+		case "h":
+			if m.Pet.Dead && m.ShowingAdoptPrompt {
+				pet.ArchivePet(m.Pet)
+				m.Pet = pet.NewHeir(m.Pet)
+				m.ShowingAdoptPrompt = false
+				m.Choice = 0
+				pet.SaveState(&m.Pet)
+				return m, nil
+			}
 		case "n":
 			if m.Pet.Dead && m.ShowingAdoptPrompt {
 				m.ShowingAdoptPrompt = false
@@ -134,7 +233,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.Choice--
 			}
 		case "down", "j":
-			if m.Choice < 4 {
+			if m.Choice < len(menuOptions)-1 {
 				m.Choice++
 			}
 		case "enter", " ":
@@ -143,22 +242,30 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 			switch m.Choice {
 			case 0:
-				if m.feed() {
-					return m, animTick(m.Animation.StartTime)
-				}
+				m.openSubMenu(SubMenuFood)
 			case 1:
-				if m.play() {
-					return m, animTick(m.Animation.StartTime)
-				}
+				m.openSubMenu(SubMenuPlay)
 			case 2:
 				if m.toggleSleep() {
 					return m, animTick(m.Animation.StartTime)
 				}
 			case 3:
-				if m.administerMedicine() {
+				m.openSubMenu(SubMenuVet)
+			case 4:
+				if m.clean() {
 					return m, animTick(m.Animation.StartTime)
 				}
-			case 4:
+			case 5:
+				if m.train() {
+					return m, animTick(m.Animation.StartTime)
+				}
+			case 6:
+				if m.performTrick() {
+					return m, animTick(m.Animation.StartTime)
+				}
+			case 7:
+				m.openSubMenu(SubMenuShop)
+			case 8:
 				m.Quitting = true
 				return m, tea.Quit
 			}
@@ -169,8 +276,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if m.Pet.Dead && !m.ShowingAdoptPrompt {
 			m.ShowingAdoptPrompt = true
 		}
+		if !m.Pet.Dead && m.Animation.Type == AnimNone {
+			m.modifyStats(func(p *pet.Pet) {
+				p.UpdateLifeStage(pet.TimeNow())
+			})
+			if m.announceEvolutions() {
+				return m, tea.Batch(tick(), animTick(m.Animation.StartTime))
+			}
+		}
 		return m, tick()
 
+	case breathTickMsg:
+		m.Breath = (m.Breath + 1) % len(breathChests)
+		return m, breathTick()
+
 	case animTickMsg:
 		// Drop ticks that belong to an older animation (e.g., if a new action started)
 		if m.Animation.Type == AnimNone || !m.Animation.StartTime.Equal(msg.started) {
@@ -192,9 +311,36 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 // Helper to modify stats and save
 func (m *Model) modifyStats(f func(*pet.Pet)) {
 	f(&m.Pet)
+	quests := m.Pet.UpdateQuests(pet.TimeNow())
 	pet.SaveState(&m.Pet)
+	m.announce(quests...)
+	m.announceAchievements()
+}
+
+// announce adds lines to the announcement shown in the message area
+func (m *Model) announce(lines ...string) {
+	for _, line := range lines {
+		if m.Announcement != "" {
+			m.Announcement += "\n"
+		}
+		m.Announcement += line
+	}
+}
+
+// announceAchievements shows any newly unlocked achievements in the message area
+func (m *Model) announceAchievements() {
+	var names []string
+	for _, def := range pet.TakeUnannouncedAchievements() {
+		names = append(names, def.Emoji+" "+def.Name)
+	}
+	if len(names) > 0 {
+		m.announce("🏆 Achievement unlocked: " + strings.Join(names, ", "))
+	}
 }
 
+// vacationSummaryDuration is how long the welcome-back summary stays on screen
+const vacationSummaryDuration = 30 * time.Second
+
 func (m *Model) setMessage(msg string) {
 	m.Message = msg
 	m.MessageExpires = pet.TimeNow().Add(3 * time.Second)
@@ -205,123 +351,238 @@ func (m *Model) startAnimation(animType AnimationType) {
 		Type:      animType,
 		Frame:     0,
 		StartTime: pet.TimeNow(),
+		Cosmetic:  m.Pet.GetCosmeticEmoji(),
 	}
 }
 
-func (m *Model) administerMedicine() bool {
-	m.modifyStats(func(p *pet.Pet) {
-		p.Illness = false
-		bondMultiplier := p.GetBondMultiplier()
-		healthGain := int(float64(pet.MedicineEffect) * bondMultiplier)
-		p.Health = min(p.Health+healthGain, pet.MaxStat)
-		p.AddInteraction("medicine")
-		p.UpdateBond(pet.BondGainWellTimed)
-		log.Printf("Administered medicine (bond mult: %.2f). Health is now %d", bondMultiplier, p.Health)
-	})
-	m.startAnimation(AnimMedicine)
-	return true
+// announceTraitChange tells the player when an action shifted the pet's personality
+func (m *Model) announceTraitChange(change *pet.TraitChange) {
+	if change != nil {
+		m.setMessage(fmt.Sprintf("🌱 %s's personality grew: %s", m.Pet.Name, change.Describe()))
+	}
 }
 
-func (m *Model) feed() bool {
-	if m.Pet.Hunger >= 90 {
-		m.setMessage("🍽️ Not hungry right now!")
-		return false
+// openMemorial shows the memorial screen, archiving the current pet first if it has died
+func (m *Model) openMemorial() {
+	if m.Pet.Dead {
+		pet.ArchivePet(m.Pet)
 	}
+	m.Memorial = pet.LoadMemorial()
+	m.InMemorial = true
+}
 
-	recentFeeds := pet.CountRecentInteractions(m.Pet.LastInteractions, "feed", pet.SpamPreventionWindow)
-	hungerBefore := m.Pet.Hunger
+// announceEvolutions starts the evolution ceremony for any evolutions not yet shown.
+// Returns true if a ceremony started.
+func (m *Model) announceEvolutions() bool {
+	pending := m.Pet.PendingEvolutions()
+	if len(pending) == 0 {
+		return false
+	}
 
+	from := pet.Pet{Form: pending[0].From}
+	to := pet.Pet{Form: pending[len(pending)-1].To}
+	m.EvolutionMessage = fmt.Sprintf("✨ %s evolved from %s into %s! ✨", m.Pet.Name, from.GetFormName(), to.GetFormName())
 	m.modifyStats(func(p *pet.Pet) {
-		p.Sleeping = false
-		p.AutoSleepTime = nil
-		p.FractionalEnergy = 0
-
-		effectiveness := 1.0
-		if recentFeeds > 0 {
-			effectiveness = 1.0 / float64(recentFeeds+1)
-		}
+		p.MarkEvolutionsAnnounced()
+	})
 
-		bondMultiplier := p.GetBondMultiplier()
-		hungerGain := int(float64(pet.FeedHungerIncrease) * p.GetTraitModifier("feed_bonus") * effectiveness * bondMultiplier)
-		happinessGain := int(float64(pet.FeedHappinessIncrease) * p.GetTraitModifier("feed_bonus_happiness") * effectiveness * bondMultiplier)
+	m.startAnimation(AnimEvolve)
+	m.Animation.FromEmoji = from.GetFormEmoji()
+	m.Animation.ToEmoji = to.GetFormEmoji()
+	return true
+}
 
-		p.Hunger = min(p.Hunger+hungerGain, pet.MaxStat)
-		p.Happiness = min(p.Happiness+happinessGain, pet.MaxStat)
-		p.AddInteraction("feed")
+// welcomeBack ends any vacation when the owner opens the TUI and shows what happened while they were away
+func (m *Model) welcomeBack() {
+	if m.Pet.Vacation == nil && m.Pet.VacationSummary == "" {
+		return
+	}
+	var summary string
+	m.modifyStats(func(p *pet.Pet) {
+		p.EndVacation(pet.TimeNow())
+		summary = p.TakeVacationSummary()
+	})
+	m.setMessage(summary)
+	m.MessageExpires = pet.TimeNow().Add(vacationSummaryDuration)
+}
 
-		if recentFeeds == 0 && hungerBefore < 50 {
-			p.UpdateBond(pet.BondGainWellTimed)
-		} else if recentFeeds == 0 {
-			p.UpdateBond(pet.BondGainNormal)
-		}
+func (m *Model) checkup() bool {
+	var message string
+	m.modifyStats(func(p *pet.Pet) {
+		message, _ = p.Checkup()
+	})
+	if message != "" {
+		m.setMessage(message)
+	}
+	return false
+}
 
-		log.Printf("Fed pet (effectiveness: %.2f, bond mult: %.2f). Hunger is now %d, Happiness is now %d",
-			effectiveness, bondMultiplier, p.Hunger, p.Happiness)
+func (m *Model) administerMedicine() bool {
+	var message string
+	var given bool
+	traitsBefore := len(m.Pet.TraitHistory)
+	m.modifyStats(func(p *pet.Pet) {
+		message, given = p.GiveMedicine()
 	})
-	m.setMessage("🍖 Yum!")
-	m.startAnimation(AnimFeed)
-	return true
+	if message != "" {
+		m.setMessage(message)
+	}
+	if len(m.Pet.TraitHistory) > traitsBefore {
+		m.announceTraitChange(&m.Pet.TraitHistory[len(m.Pet.TraitHistory)-1])
+	}
+	if given {
+		m.startAnimation(AnimMedicine)
+	}
+	return given
 }
 
-func (m *Model) play() bool {
-	if m.Pet.Energy < pet.AutoSleepThreshold {
-		m.setMessage(fmt.Sprintf("%s Too tired to play...", pet.StatusEmojiSleeping))
-		return false
+// openSubMenu shows an action's options, restocking the pantry before choosing food
+func (m *Model) openSubMenu(menu SubMenu) {
+	if menu == SubMenuFood {
+		m.modifyStats(func(p *pet.Pet) {
+			p.RestockPantry(pet.TimeNow())
+		})
 	}
+	m.SubMenu = menu
+	m.SubMenuChoice = 0
+}
 
-	if m.Pet.Mood == "lazy" && m.Pet.Energy < 50 {
-		m.setMessage("😪 Not in the mood to play...")
-		return false
+// subMenuOptions returns the food or activity types listed in the open sub-menu
+func (m Model) subMenuOptions() []string {
+	var options []string
+	switch m.SubMenu {
+	case SubMenuFood:
+		for _, food := range pet.GetFoodDefinitions() {
+			options = append(options, food.Type)
+		}
+	case SubMenuPlay:
+		for _, activity := range pet.GetPlayActivityDefinitions() {
+			options = append(options, activity.Type)
+		}
+	case SubMenuVet:
+		options = vetOptions
+	case SubMenuShop:
+		for _, item := range pet.GetShopItems() {
+			options = append(options, item.ID)
+		}
 	}
+	return options
+}
 
-	currentHour := pet.TimeNow().Local().Hour()
-	isActive := pet.IsActiveHours(&m.Pet, currentHour)
+// selectSubMenuOption feeds, plays, visits the vet or shops with the chosen option. Returns true if an animation started.
+func (m *Model) selectSubMenuOption(option string) bool {
+	switch m.SubMenu {
+	case SubMenuFood:
+		return m.feed(option)
+	case SubMenuPlay:
+		return m.play(option)
+	case SubMenuVet:
+		if option == VetCheckup {
+			return m.checkup()
+		}
+		return m.administerMedicine()
+	case SubMenuShop:
+		m.buy(option)
+	}
+	return false
+}
 
-	recentPlays := pet.CountRecentInteractions(m.Pet.LastInteractions, "play", pet.SpamPreventionWindow)
-	happinessBefore := m.Pet.Happiness
+// buy spends coins on a shop item, leaving the shop open for more purchases
+func (m *Model) buy(itemID string) {
+	var message string
+	m.modifyStats(func(p *pet.Pet) {
+		message, _ = p.Buy(itemID)
+	})
+	if message != "" {
+		m.setMessage(message)
+	}
+}
 
+func (m *Model) feed(foodType string) bool {
+	var message string
+	var fed bool
+	traitsBefore := len(m.Pet.TraitHistory)
 	m.modifyStats(func(p *pet.Pet) {
-		p.Sleeping = false
-		p.AutoSleepTime = nil
-		p.FractionalEnergy = 0
+		message, fed = p.Feed(foodType)
+	})
+	if message != "" {
+		m.setMessage(message)
+	}
+	if len(m.Pet.TraitHistory) > traitsBefore {
+		m.announceTraitChange(&m.Pet.TraitHistory[len(m.Pet.TraitHistory)-1])
+	}
+	if fed {
+		m.startAnimation(AnimFeed)
+	}
+	return fed
+}
 
-		effectiveness := 1.0
-		if recentPlays > 0 {
-			effectiveness = 1.0 / float64(recentPlays+1)
-		}
+func (m *Model) play(activity string) bool {
+	var message string
+	var played bool
+	traitsBefore := len(m.Pet.TraitHistory)
+	m.modifyStats(func(p *pet.Pet) {
+		message, played = p.Play(activity)
+	})
+	if message != "" {
+		m.setMessage(message)
+	}
+	if len(m.Pet.TraitHistory) > traitsBefore {
+		m.announceTraitChange(&m.Pet.TraitHistory[len(m.Pet.TraitHistory)-1])
+	}
+	if played {
+		m.startAnimation(playAnimations[activity])
+	}
+	return played
+}
 
-		bondMultiplier := p.GetBondMultiplier()
-		happinessGain := float64(pet.PlayHappinessIncrease)
-		if !isActive {
-			happinessGain *= pet.OutsideActiveHappinessMult
-		}
-		happinessGain *= p.GetTraitModifier("play_bonus")
-		happinessGain *= bondMultiplier * effectiveness
-
-		p.Happiness = min(p.Happiness+int(happinessGain), pet.MaxStat)
-		p.Energy = max(p.Energy-pet.PlayEnergyDecrease, pet.MinStat)
-		p.Hunger = max(p.Hunger-pet.PlayHungerDecrease, pet.MinStat)
-		p.AddInteraction("play")
-
-		if recentPlays == 0 && happinessBefore < 50 {
-			p.UpdateBond(pet.BondGainWellTimed)
-		} else if recentPlays == 0 {
-			p.UpdateBond(pet.BondGainNormal)
-		}
+func (m *Model) clean() bool {
+	var message string
+	var cleaned bool
+	traitsBefore := len(m.Pet.TraitHistory)
+	m.modifyStats(func(p *pet.Pet) {
+		message, cleaned = p.Clean()
+	})
+	if message != "" {
+		m.setMessage(message)
+	}
+	if len(m.Pet.TraitHistory) > traitsBefore {
+		m.announceTraitChange(&m.Pet.TraitHistory[len(m.Pet.TraitHistory)-1])
+	}
+	if cleaned {
+		m.startAnimation(AnimClean)
+	}
+	return cleaned
+}
 
-		log.Printf("Played with pet (effectiveness: %.2f, bond mult: %.2f). Happiness is now %d, Energy is now %d, Hunger is now %d",
-			effectiveness, bondMultiplier, p.Happiness, p.Energy, p.Hunger)
+func (m *Model) train() bool {
+	var message string
+	var trained bool
+	m.modifyStats(func(p *pet.Pet) {
+		message, trained = p.Train()
 	})
+	if message != "" {
+		m.setMessage(message)
+	}
+	if trained {
+		m.startAnimation(AnimTrain)
+	}
+	return trained
+}
 
-	if !isActive {
-		m.setMessage("🥱 *yawn* ...play time...")
-	} else if m.Pet.Mood == "playful" {
-		m.setMessage("🎉 So much fun!")
-	} else {
-		m.setMessage("🎾 Wheee!")
+func (m *Model) performTrick() bool {
+	var message string
+	var performed bool
+	m.modifyStats(func(p *pet.Pet) {
+		message, performed = p.PerformTrick()
+	})
+	if message != "" {
+		m.setMessage(message)
 	}
-	m.startAnimation(AnimPlay)
-	return true
+	if performed {
+		m.startAnimation(AnimTrick)
+	}
+	return performed
 }
 
 func (m *Model) toggleSleep() bool {
@@ -340,7 +601,17 @@ func (m *Model) toggleSleep() bool {
 func (m *Model) updateHourlyStats(t time.Time) {
 	m.modifyStats(func(p *pet.Pet) {
 		if int(t.Minute()) == 0 {
-			p.RecordStatCheckpoint()
+			p.RecordStatCheckpointIfDue()
+		}
+
+		// Nothing decays while the owner asked not to be disturbed
+		quiet := p.LoadQuietSchedule()
+		if quiet.IsQuiet(t) {
+			return
+		}
+
+		if int(t.Minute()) == 0 {
+			p.UpdateDisease(quiet, t.Add(-time.Hour), t)
 		}
 
 		if int(t.Minute()) == 0 {
@@ -364,6 +635,19 @@ func (m *Model) updateHourlyStats(t time.Time) {
 			}
 		}
 
+		if int(t.Minute()) == 0 {
+			cleanlinessRate := pet.CleanlinessDecreaseRate
+			if p.Sleeping {
+				cleanlinessRate = pet.SleepingCleanlinessRate
+			}
+			p.Cleanliness = max(p.Cleanliness-cleanlinessRate, pet.MinStat)
+			log.Printf("Cleanliness decreased to %d", p.Cleanliness)
+		}
+
+		if int(t.Minute()) == 0 {
+			p.UpdateWeight(1)
+		}
+
 		if p.Hunger < 30 || p.Energy < 30 {
 			if int(t.Minute()) == 0 {
 				p.Happiness = max(p.Happiness-2, 0)
@@ -381,6 +665,11 @@ func (m *Model) updateHourlyStats(t time.Time) {
 				log.Printf("Health decreased to %d", p.Health)
 			}
 		}
+
+		if p.IsDirty() && int(t.Minute()) == 0 {
+			p.Health = max(p.Health-pet.DirtyHealthDecayRate, pet.MinStat)
+			log.Printf("Health decreased to %d from a dirty environment", p.Health)
+		}
 	})
 }
 
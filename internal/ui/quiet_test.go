@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"vpet/internal/pet"
+)
+
+func TestHourlyStatsPauseDuringQuietHours(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	tick := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	pet.SaveQuietHours(pet.QuietHours{DND: &pet.TimeRange{Start: tick.Add(-time.Hour), End: tick.Add(time.Hour)}})
+
+	p := pet.NewPet(nil)
+	p.Traits = nil
+	p.Hunger, p.Energy, p.Happiness, p.Health, p.Cleanliness = 10, 10, 10, 50, 10
+	m := Model{Pet: p}
+
+	m.updateHourlyStats(tick)
+	if m.Pet.Hunger != 10 || m.Pet.Happiness != 10 || m.Pet.Health != 50 || m.Pet.Cleanliness != 10 {
+		t.Errorf("Expected no decay during do not disturb, got hunger %d happiness %d health %d cleanliness %d",
+			m.Pet.Hunger, m.Pet.Happiness, m.Pet.Health, m.Pet.Cleanliness)
+	}
+
+	m.updateHourlyStats(tick.Add(2 * time.Hour))
+	if m.Pet.Hunger >= 10 || m.Pet.Health >= 50 {
+		t.Errorf("Expected decay to resume after do not disturb, got hunger %d health %d", m.Pet.Hunger, m.Pet.Health)
+	}
+}
@@ -0,0 +1,167 @@
+package ui
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"vpet/internal/pet"
+)
+
+// BreathInterval is how long each idle breathing frame lasts
+const BreathInterval = 900 * time.Millisecond
+
+// NoEmoji swaps emoji in the title and animations for ASCII sprites, for terminals where emoji width breaks layout
+var NoEmoji = false
+
+// TerminalSupportsEmoji guesses whether the terminal can draw emoji at a predictable width. The Linux console and
+// non-UTF-8 locales can't.
+func TerminalSupportsEmoji() bool {
+	switch os.Getenv("TERM") {
+	case "linux", "vt100", "vt220", "dumb":
+		return false
+	}
+	for _, name := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		if value := os.Getenv(name); value != "" {
+			value = strings.ToUpper(value)
+			return strings.Contains(value, "UTF-8") || strings.Contains(value, "UTF8")
+		}
+	}
+	return true
+}
+
+// spriteBodies are the body templates for each life stage. {eyes} takes 3 columns, {mouth} 1 and {chest} 7.
+var spriteBodies = map[int][]string{
+	pet.StageBaby: {
+		"   .---.   ",
+		"  ( {eyes} )  ",
+		"   \\ {mouth} /   ",
+		"  {chest}  ",
+		"   `---'   ",
+	},
+	pet.StageChild: {
+		"   /\\_/\\   ",
+		"  ( {eyes} )  ",
+		"   > {mouth} <   ",
+		"  {chest}  ",
+		"   _| |_   ",
+	},
+	pet.StageTeen: {
+		"   /\\_/\\   ",
+		"  ( {eyes} )  ",
+		"  =( {mouth} )=  ",
+		"  {chest}  ",
+		"  _/   \\_  ",
+	},
+	pet.StageAdult: {
+		"  /\\___/\\  ",
+		"  ( {eyes} )  ",
+		"  ( ={mouth}= )  ",
+		" /{chest}\\ ",
+		" (__) (__) ",
+	},
+	pet.StageSenior: {
+		"  /\\___/\\  ",
+		"  ( {eyes} )  ",
+		"  ( ~{mouth}~ )  ",
+		"  {chest}  ",
+		"  (_) (_)! ",
+	},
+}
+
+// spriteCrests give every form its own line above the body
+var spriteCrests = map[pet.PetForm]string{
+	pet.FormBaby:            "   . ' .   ",
+	pet.FormHealthyChild:    "     +     ",
+	pet.FormTroubledChild:   "     ?     ",
+	pet.FormSicklyChild:     "    ~~~    ",
+	pet.FormHealthyTeen:     "     ^     ",
+	pet.FormTroubledTeen:    "    ...    ",
+	pet.FormSicklyTeen:      "   achoo   ",
+	pet.FormProdigyTeen:     "   _[=]_   ",
+	pet.FormRebelTeen:       "    ///    ",
+	pet.FormEliteAdult:      "    \\*/    ",
+	pet.FormStandardAdult:   "     '     ",
+	pet.FormGrumpyAdult:     "   ~~#~~   ",
+	pet.FormRedeemedAdult:   "    ---    ",
+	pet.FormDelinquentAdult: "    xXx    ",
+	pet.FormWeakAdult:       "    +-+    ",
+	pet.FormDevotedAdult:    "    <3     ",
+	pet.FormPerformerAdult:  "   ~*~*~   ",
+	pet.FormFreeSpiritAdult: "   ~ ~ ~   ",
+	pet.FormSurvivorAdult:   "    /|\\    ",
+	pet.FormPhoenixAdult:    "  ^v^v^v^  ",
+	pet.FormWiseElder:       "    /**\\   ",
+	pet.FormSereneElder:     "   .   .   ",
+	pet.FormGoldenElder:     "    [$]    ",
+	pet.FormCrankyElder:     "    !!!    ",
+	pet.FormFrailElder:      "    ' '    ",
+	pet.FormLegendElder:     "  /\\   /\\  ",
+}
+
+// cosmeticCrests draw hats in place of the form's crest
+var cosmeticCrests = map[string]string{
+	pet.ShopBow:    "    >o<    ",
+	pet.ShopTopHat: "   _|=|_   ",
+	pet.ShopCrown:  "    www    ",
+}
+
+// spriteFace fills the eyes and mouth of a body template
+type spriteFace struct {
+	eyes, mouth string
+}
+
+// moodFaces are the expressions for each mood; unknown moods look normal
+var moodFaces = map[string]spriteFace{
+	"normal":  {"o o", "-"},
+	"playful": {"^ ^", "w"},
+	"lazy":    {"= =", "~"},
+	"needy":   {"T T", "o"},
+}
+
+var (
+	sleepingFace = spriteFace{"- -", "."}
+	shadesEyes   = "O-O"
+)
+
+// breathChests alternate to make the pet breathe out and in
+var breathChests = [2]string{" (   ) ", "(     )"}
+
+// sleepBubbles float above a sleeping pet, alternating with each breath
+var sleepBubbles = [2]string{"         z ", "        Z  "}
+
+// GetSprite returns the ASCII art for the pet's form, mood and sleep state at a breathing frame
+func GetSprite(p pet.Pet, breath int) string {
+	def := pet.GetFormDefinition(p.Form)
+	stage := pet.StageBaby
+	if def != nil {
+		stage = def.Stage
+	}
+	breath %= len(breathChests)
+
+	face, ok := moodFaces[p.Mood]
+	if !ok {
+		face = moodFaces["normal"]
+	}
+	if p.Sleeping {
+		face = sleepingFace
+	} else if p.Cosmetic == pet.ShopSunglasses {
+		face.eyes = shadesEyes
+	}
+
+	crest := spriteCrests[p.Form]
+	if hat, ok := cosmeticCrests[p.Cosmetic]; ok {
+		crest = hat
+	}
+
+	var lines []string
+	if p.Sleeping {
+		lines = append(lines, sleepBubbles[breath])
+	}
+	lines = append(lines, crest)
+	replacer := strings.NewReplacer("{eyes}", face.eyes, "{mouth}", face.mouth, "{chest}", breathChests[breath])
+	for _, line := range spriteBodies[stage] {
+		lines = append(lines, replacer.Replace(line))
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,142 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"vpet/internal/pet"
+)
+
+func TestSpritesCoverEveryForm(t *testing.T) {
+	crests := make(map[string]pet.PetForm)
+	for _, def := range pet.GetFormDefinitions() {
+		crest, ok := spriteCrests[def.Form]
+		if !ok {
+			t.Errorf("No sprite crest for %s", def.Name)
+			continue
+		}
+		if other, seen := crests[crest]; seen {
+			t.Errorf("%s shares a crest with form %d", def.Name, other)
+		}
+		crests[crest] = def.Form
+
+		for _, mood := range []string{"normal", "playful", "lazy", "needy"} {
+			for _, sleeping := range []bool{false, true} {
+				for breath := 0; breath < 2; breath++ {
+					p := pet.Pet{Form: def.Form, Mood: mood, Sleeping: sleeping}
+					for _, line := range strings.Split(GetSprite(p, breath), "\n") {
+						if width := pet.DisplayWidth(line); width != 11 {
+							t.Errorf("%s sprite line %q is %d columns wide", def.Name, line, width)
+						}
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestSpriteVariants(t *testing.T) {
+	p := pet.Pet{Form: pet.FormStandardAdult, Mood: "normal"}
+	normal := GetSprite(p, 0)
+
+	p.Mood = "playful"
+	if sprite := GetSprite(p, 0); sprite == normal || !strings.Contains(sprite, "^ ^") {
+		t.Errorf("Expected a playful face, got:\n%s", sprite)
+	}
+
+	p.Sleeping = true
+	sleeping := GetSprite(p, 0)
+	if !strings.Contains(sleeping, "- -") || !strings.Contains(sleeping, "z") {
+		t.Errorf("Expected closed eyes and a sleep bubble, got:\n%s", sleeping)
+	}
+
+	p = pet.Pet{Form: pet.FormStandardAdult, Cosmetic: pet.ShopCrown}
+	if sprite := GetSprite(p, 0); !strings.Contains(sprite, "www") {
+		t.Errorf("Expected the crown in place of the crest, got:\n%s", sprite)
+	}
+}
+
+func TestSpriteBreathes(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	p := pet.NewPet(nil)
+	m := Model{Pet: p}
+	before := m.View()
+
+	updated, cmd := m.Update(breathTickMsg(time.Now()))
+	m = updated.(Model)
+	if cmd == nil {
+		t.Error("Expected the breathing ticker to keep running")
+	}
+	if m.Breath != 1 || m.View() == before {
+		t.Error("Expected the sprite to change with each breath")
+	}
+
+	updated, _ = m.Update(breathTickMsg(time.Now()))
+	if updated.(Model).Breath != 0 {
+		t.Error("Expected breathing to loop")
+	}
+}
+
+func TestNoEmojiFallback(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	NoEmoji = true
+	t.Cleanup(func() {
+		pet.TestConfigPath = ""
+		NoEmoji = false
+	})
+
+	p := pet.NewPet(nil)
+	m := Model{Pet: p}
+	if title := m.renderTitle(); strings.Contains(title, p.GetFormEmoji()) || !strings.Contains(title, p.Name) {
+		t.Errorf("Expected a plain-text title, got %q", title)
+	}
+
+	m.startAnimation(AnimFeed)
+	view := m.View()
+	if !strings.Contains(view, "*munch*") || !strings.Contains(view, "( o o )") || strings.Contains(view, "🍖") {
+		t.Errorf("Expected the feeding animation as a sprite, got:\n%s", view)
+	}
+}
+
+func TestTerminalSupportsEmoji(t *testing.T) {
+	tests := []struct {
+		term, lang string
+		want       bool
+	}{
+		{"xterm-256color", "en_US.UTF-8", true},
+		{"xterm-256color", "C", false},
+		{"linux", "en_US.UTF-8", false},
+		{"screen", "", true},
+	}
+	for _, tt := range tests {
+		t.Setenv("TERM", tt.term)
+		t.Setenv("LC_ALL", "")
+		t.Setenv("LC_CTYPE", "")
+		t.Setenv("LANG", tt.lang)
+		if got := TerminalSupportsEmoji(); got != tt.want {
+			t.Errorf("TERM=%s LANG=%s: got %v, want %v", tt.term, tt.lang, got, tt.want)
+		}
+	}
+}
+
+func TestASCIIProfileView(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	pet.SetProfile(pet.ProfileASCII)
+	NoEmoji = true
+	t.Cleanup(func() {
+		pet.TestConfigPath = ""
+		pet.SetProfile(pet.ProfileEmoji)
+		NoEmoji = false
+	})
+
+	m := Model{Pet: pet.NewPet(nil)}
+	for _, r := range m.View() {
+		if r > 127 {
+			t.Fatalf("Expected a plain ASCII view, found %q in:\n%s", r, m.View())
+		}
+	}
+}
@@ -10,6 +10,9 @@ import (
 	"vpet/internal/pet"
 )
 
+// statsInnerWidth is the number of columns between the box's left padding and its right border
+const statsInnerWidth = 33
+
 // StatsModel is a simple Bubble Tea model for displaying stats
 type StatsModel struct {
 	Pet pet.Pet
@@ -48,51 +51,135 @@ func (m StatsModel) View() string {
 		return bar
 	}
 
-	formEmoji := m.Pet.GetFormEmoji()
 	formName := m.Pet.GetFormName()
 	status := pet.GetStatus(m.Pet)
-	illnessStatus := "No"
-	if m.Pet.Illness {
-		illnessStatus = "Yes"
-	}
+	illnessStatus := pet.GetIllnessDisplay(m.Pet)
 
-	chronoEmoji := pet.GetChronotypeEmoji(m.Pet.Chronotype)
-	chronoName := pet.GetChronotypeName(m.Pet.Chronotype)
-	wakeHour, sleepHour := pet.GetChronotypeSchedule(m.Pet.Chronotype)
-	chronoDisplay := fmt.Sprintf("%s %s (%d:00-%d:00)", chronoEmoji, chronoName, wakeHour, sleepHour)
+	chronoDisplay := pet.GetScheduleDisplay(m.Pet)
 
-	var traitNames []string
-	for _, trait := range m.Pet.Traits {
-		traitNames = append(traitNames, trait.Name)
-	}
-	traitDisplay := strings.Join(traitNames, ", ")
-	if traitDisplay == "" {
-		traitDisplay = "None"
-	}
+	traitDisplay := pet.GetTraitsDisplay(m.Pet)
 
 	bondDisplay := pet.GetBondDescription(m.Pet.Bond)
+	forecast := pet.ForecastEvolution(m.Pet, pet.TimeNow())
 
 	var s strings.Builder
-	s.WriteString("╔════════════════════════════════════╗\n")
-	s.WriteString(fmt.Sprintf("║  %s %s %s                  ║\n", formEmoji, m.Pet.Name, formEmoji))
-	s.WriteString("╠════════════════════════════════════╣\n")
-	s.WriteString(fmt.Sprintf("║  Form:    %-24s ║\n", formName))
-	s.WriteString(fmt.Sprintf("║  Type:    %-24s ║\n", chronoDisplay))
-	s.WriteString(fmt.Sprintf("║  Traits:  %-24s ║\n", traitDisplay))
-	s.WriteString(fmt.Sprintf("║  Bond:    %-24s ║\n", bondDisplay))
-	s.WriteString(fmt.Sprintf("║  Age:     %-24s ║\n", fmt.Sprintf("%d hours", m.Pet.Age)))
-	s.WriteString(fmt.Sprintf("║  Status:  %-24s ║\n", status))
-	s.WriteString("║                                    ║\n")
-	s.WriteString(fmt.Sprintf("║  Hunger:    [%s] %3d%%           ║\n", makeBar(m.Pet.Hunger), m.Pet.Hunger))
-	s.WriteString(fmt.Sprintf("║  Happiness: [%s] %3d%%           ║\n", makeBar(m.Pet.Happiness), m.Pet.Happiness))
-	s.WriteString(fmt.Sprintf("║  Energy:    [%s] %3d%%           ║\n", makeBar(m.Pet.Energy), m.Pet.Energy))
-	s.WriteString(fmt.Sprintf("║  Health:    [%s] %3d%%           ║\n", makeBar(m.Pet.Health), m.Pet.Health))
-	s.WriteString("║                                    ║\n")
-	s.WriteString(fmt.Sprintf("║  Illness:   %-23s║\n", illnessStatus))
-	s.WriteString("╚════════════════════════════════════╝\n")
-	s.WriteString("\nPress ESC, click, or any key to close...")
-
-	return s.String()
+	frame, text := gameStyles.border.Render, gameStyles.menu.Render
+	// row writes one line inside the box, padded by display width so emoji and ASCII tokens keep the border straight
+	row := func(line string) {
+		s.WriteString(frame("║") + "  " + text(pet.PadDisplay(pet.RenderText(line), statsInnerWidth)) + " " + frame("║") + "\n")
+	}
+	// field wraps long values onto continuation rows under the value column instead of cutting them off
+	field := func(label, value string) {
+		name := label + ":"
+		for i, line := range wrapDisplay(pet.RenderText(value), statsInnerWidth-10) {
+			if i > 0 {
+				name = ""
+			}
+			row(fmt.Sprintf("%-9s %s", name, line))
+		}
+	}
+	// detail writes an indented row under a field, wrapping it the same way
+	detail := func(line string) {
+		for i, part := range wrapDisplay(pet.RenderText(line), statsInnerWidth-4) {
+			if i == 0 {
+				row("  " + part)
+			} else {
+				row("    " + part)
+			}
+		}
+	}
+	bar := func(label string, value int) {
+		row(fmt.Sprintf("%-11s [%s] %3d%%", label+":", makeBar(value), value))
+	}
+
+	s.WriteString(frame("╔════════════════════════════════════╗") + "\n")
+	row(statsTitle(m.Pet))
+	s.WriteString(frame("╠════════════════════════════════════╣") + "\n")
+	field("Form", formName)
+	field("Type", chronoDisplay)
+	field("Clock", pet.GetTimeZoneDisplay(m.Pet))
+	field("Traits", traitDisplay)
+	field("Growing", pet.GetTraitDriftDisplay(m.Pet))
+	for _, change := range m.Pet.TraitHistory {
+		detail(fmt.Sprintf("%s %s", m.Pet.LocalTime(change.Time).Format("Jan 2"), change.Describe()))
+	}
+	field("Bond", bondDisplay)
+	field("Tricks", pet.GetTricksDisplay(m.Pet))
+	field("Age", fmt.Sprintf("%d hours", m.Pet.Age))
+	field("Quests", pet.GetQuestSummary(m.Pet))
+	for _, line := range pet.GetQuestLines(m.Pet) {
+		detail(line)
+	}
+	field("Coins", pet.GetCoinDisplay(pet.LoadWallet().Balance))
+	field("Status", status)
+	row("")
+	bar("Hunger", m.Pet.Hunger)
+	bar("Happiness", m.Pet.Happiness)
+	bar("Energy", m.Pet.Energy)
+	bar("Health", m.Pet.Health)
+	bar("Clean", m.Pet.Cleanliness)
+	row("")
+	row(fmt.Sprintf("%-11s %s", "Weight:", pet.GetWeightDisplay(m.Pet)))
+	row(fmt.Sprintf("%-11s %s", "Illness:", illnessStatus))
+	if mess := pet.GetMessDisplay(m.Pet); mess != "" {
+		row(fmt.Sprintf("%-11s %s", "Mess:", mess))
+	}
+	row("")
+	field("Family", pet.GetFamilyDisplay(m.Pet))
+	if len(m.Pet.Ancestry) > 0 {
+		detail(pet.GetFamilyTreeDisplay(m.Pet))
+	}
+	field("Lineage", pet.GetLineageDisplay(m.Pet))
+	for _, record := range m.Pet.EvolutionHistory {
+		form := pet.Pet{Form: record.To}
+		detail(fmt.Sprintf("%s %s %d%%", m.Pet.LocalTime(record.Time).Format("Jan 2"), form.GetFormName(), record.CareQuality))
+	}
+	field("Next", forecast.ProjectedFormDisplay())
+	field("In", forecast.TimeUntilDisplay())
+	field("Care", fmt.Sprintf("%d%% avg, %s lowest", forecast.Care.OverallAverage(), forecast.WeakestStat.Name))
+	averages := forecast.StatAverages()
+	nameWidth := 0
+	for _, avg := range averages {
+		nameWidth = max(nameWidth, len(avg.Name)+1)
+	}
+	for _, avg := range averages {
+		row(fmt.Sprintf("  %-*s [%s] %3d%%", nameWidth, avg.Name+":", makeBar(avg.Value), avg.Value))
+	}
+	s.WriteString(frame("╚════════════════════════════════════╝") + "\n")
+	s.WriteString("\n" + frame("Press ESC, click, or any key to close..."))
+
+	return pet.RenderText(s.String())
+}
+
+// statsTitle puts the dressed emoji on both sides of the name when there's room, then on one side, then the bare
+// form, so a long name or wide ASCII tokens never push the cosmetic past the border
+func statsTitle(p pet.Pet) string {
+	form, dressed := p.GetFormEmoji(), p.GetDressedEmoji()
+	for _, title := range []string{dressed + " " + p.Name + " " + dressed, dressed + " " + p.Name + " " + form} {
+		if pet.DisplayWidth(title) <= statsInnerWidth {
+			return title
+		}
+	}
+	return form + " " + p.Name + " " + form
+}
+
+// wrapDisplay breaks s at spaces into lines no wider than width columns. A single word wider than
+// that keeps a line to itself.
+func wrapDisplay(s string, width int) []string {
+	var lines []string
+	current := ""
+	for _, word := range strings.Fields(s) {
+		switch {
+		case current == "":
+			current = word
+		case pet.DisplayWidth(current+" "+word) <= width:
+			current += " " + word
+		default:
+			lines = append(lines, current)
+			current = word
+		}
+	}
+	return append(lines, current)
 }
 
 // DisplayStats shows the stats display
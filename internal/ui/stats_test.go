@@ -0,0 +1,113 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"vpet/internal/pet"
+)
+
+func TestStatsModelShowsWeight(t *testing.T) {
+	p := pet.NewPet(nil)
+	p.Weight = pet.OverweightThreshold + 2
+
+	view := StatsModel{Pet: p}.View()
+	if !strings.Contains(view, "Overweight (+22)") {
+		t.Errorf("Expected stats to show the weight class, got:\n%s", view)
+	}
+}
+
+func TestStatsBoxAligned(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() {
+		pet.TestConfigPath = ""
+		pet.SetProfile(pet.ProfileEmoji)
+	})
+
+	for _, profile := range []string{pet.ProfileEmoji, pet.ProfileASCII} {
+		pet.SetProfile(profile)
+		for _, cosmetic := range []string{"", pet.ShopCrown, pet.ShopSunglasses} {
+			p := pet.NewPet(nil)
+			p.Name = "Mochi"
+			p.Cosmetic = cosmetic
+			p.Hunger = 10
+			p.UpdateQuests(pet.TimeNow())
+
+			view := StatsModel{Pet: p}.View()
+			lines := strings.Split(view, "\n")
+			border := pet.DisplayWidth(lines[0])
+			for _, line := range lines {
+				if line == "" || strings.HasPrefix(line, "Press") {
+					continue
+				}
+				if width := pet.DisplayWidth(line); width != border {
+					t.Errorf("%s profile, cosmetic %q: row is %d columns, border is %d: %q", profile, cosmetic, width, border, line)
+				}
+			}
+			if cosmetic != "" && !strings.Contains(lines[1], p.GetCosmeticEmoji()) {
+				t.Errorf("Expected cosmetic %q in the title, got %q", cosmetic, lines[1])
+			}
+			if profile == pet.ProfileASCII && strings.ContainsAny(view, "║═😸🙀█") {
+				t.Errorf("Expected plain ASCII stats, got:\n%s", view)
+			}
+		}
+	}
+}
+
+func TestStatsWrapLongValues(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	p := pet.NewPet(nil)
+	p.Traits = pet.GenerateTraits()
+	p.Tricks = []pet.Trick{{Name: "Sit", Proficiency: pet.MaxStat}, {Name: "Paw", Proficiency: 50}, {Name: "Spin", Proficiency: 20}}
+	if len(p.Traits) < 8 {
+		t.Fatalf("Expected a trait in every category, got %d", len(p.Traits))
+	}
+
+	view := StatsModel{Pet: p}.View()
+	lines := strings.Split(view, "\n")
+	border := pet.DisplayWidth(lines[0])
+	var text strings.Builder
+	for _, line := range lines {
+		if line == "" || strings.HasPrefix(line, "Press") {
+			continue
+		}
+		if width := pet.DisplayWidth(line); width != border {
+			t.Errorf("Row is %d columns, border is %d: %q", width, border, line)
+		}
+		text.WriteString(strings.Trim(line, "║ ") + " ")
+	}
+	joined := strings.Join(strings.Fields(text.String()), " ")
+	for _, want := range append([]string{pet.GetTraitsDisplay(p), pet.GetTricksDisplay(p)}, pet.GetScheduleDisplay(p)) {
+		if !strings.Contains(joined, want) {
+			t.Errorf("Expected %q to be shown in full, got:\n%s", want, view)
+		}
+	}
+}
+
+func TestStatsCareBarsAligned(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+
+	view := StatsModel{Pet: pet.NewPet(nil)}.View()
+	lines := strings.Split(view, "\n")
+	column := -1
+	for i, line := range lines {
+		if !strings.Contains(line, "Care:") {
+			continue
+		}
+		for _, care := range lines[i+1 : i+6] {
+			bar := pet.DisplayWidth(care[:strings.Index(care, "[")])
+			if column == -1 {
+				column = bar
+			} else if bar != column {
+				t.Errorf("Expected care bars to start at column %d, got %d: %q", column, bar, care)
+			}
+		}
+	}
+	if column == -1 {
+		t.Fatalf("Expected a care breakdown, got:\n%s", view)
+	}
+}
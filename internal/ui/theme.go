@@ -0,0 +1,50 @@
+package ui
+
+import (
+	"github.com/charmbracelet/lipgloss"
+
+	"vpet/internal/pet"
+)
+
+// styles are the lipgloss styles the TUI and stats popup draw with, built from a theme
+type styles struct {
+	title     lipgloss.Style
+	status    lipgloss.Style
+	menu      lipgloss.Style
+	menuBox   lipgloss.Style
+	stats     lipgloss.Style
+	sprite    lipgloss.Style
+	animation lipgloss.Style
+	alert     lipgloss.Style
+	border    lipgloss.Style
+}
+
+var gameStyles = newStyles(*pet.GetBuiltinTheme(pet.ThemeDark))
+
+// ApplyTheme restyles the TUI and stats popup
+func ApplyTheme(theme pet.Theme) {
+	gameStyles = newStyles(theme)
+}
+
+// themed returns a style in one of the theme's colors, leaving the terminal default when the color is empty
+func themed(theme pet.Theme, color string) lipgloss.Style {
+	style := lipgloss.NewStyle().Bold(theme.Bold)
+	if color != "" {
+		style = style.Foreground(lipgloss.Color(color))
+	}
+	return style
+}
+
+func newStyles(theme pet.Theme) styles {
+	return styles{
+		title:     themed(theme, theme.Primary).Bold(true).Padding(0, 1),
+		status:    themed(theme, theme.Primary).Width(30),
+		stats:     themed(theme, theme.Primary).Width(30),
+		menu:      themed(theme, theme.Primary),
+		menuBox:   lipgloss.NewStyle().Padding(0, 2),
+		sprite:    themed(theme, theme.Primary).Padding(0, 2),
+		animation: themed(theme, theme.Accent).Bold(true).Padding(1, 2),
+		alert:     themed(theme, theme.Alert).Bold(true),
+		border:    themed(theme, theme.Border),
+	}
+}
@@ -0,0 +1,26 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+
+	"vpet/internal/pet"
+)
+
+func TestApplyTheme(t *testing.T) {
+	t.Cleanup(func() { ApplyTheme(*pet.GetBuiltinTheme(pet.ThemeDark)) })
+
+	ApplyTheme(*pet.GetBuiltinTheme(pet.ThemeHighContrast))
+	if !gameStyles.menu.GetBold() || !gameStyles.border.GetBold() {
+		t.Error("Expected the high-contrast theme to bold all text")
+	}
+	if got := gameStyles.alert.GetForeground(); got != lipgloss.Color("9") {
+		t.Errorf("Expected the high-contrast alert color, got %v", got)
+	}
+
+	ApplyTheme(*pet.GetBuiltinTheme(pet.ThemeMonochrome))
+	if got := gameStyles.menu.GetForeground(); got != lipgloss.TerminalColor(lipgloss.NoColor{}) {
+		t.Errorf("Expected monochrome to leave the default color, got %v", got)
+	}
+}
@@ -10,35 +10,15 @@ import (
 	"vpet/internal/pet"
 )
 
-var gameStyles = struct {
-	title   lipgloss.Style
-	status  lipgloss.Style
-	menu    lipgloss.Style
-	menuBox lipgloss.Style
-	stats   lipgloss.Style
-}{
-	title: lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("#FF75B5")).
-		Padding(0, 1),
-
-	status: lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FF75B5")).
-		Width(30),
-
-	stats: lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FF75B5")).
-		Width(30),
-
-	menu: lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FF75B5")),
-
-	menuBox: lipgloss.NewStyle().
-		Padding(0, 2),
-}
-
 // View implements tea.Model
 func (m Model) View() string {
+	return pet.RenderText(m.view())
+}
+
+func (m Model) view() string {
+	if m.InMemorial {
+		return m.renderMemorial()
+	}
 	if m.Pet.Dead {
 		return m.deadView()
 	}
@@ -54,8 +34,7 @@ func (m Model) View() string {
 		return m.renderAnimation()
 	}
 
-	formEmoji := m.Pet.GetFormEmoji()
-	title := gameStyles.title.Render(formEmoji + " " + m.Pet.Name + " " + formEmoji)
+	title := m.renderTitle()
 	stats := m.renderStats()
 	status := m.renderStatus()
 	menu := m.renderMenu()
@@ -73,24 +52,36 @@ func (m Model) View() string {
 
 	sections := []string{
 		title,
+		gameStyles.sprite.Render(GetSprite(m.Pet, m.Breath)),
 		"",
 		stats,
 		"",
 		status,
 	}
 
+	if m.EvolutionMessage != "" {
+		sections = append(sections, "", gameStyles.title.Render(m.EvolutionMessage))
+	}
+
 	if eventView != "" {
 		sections = append(sections, "", eventView, gameStyles.status.Render("Press [E] to respond!"))
 	}
 
+	if m.Announcement != "" {
+		sections = append(sections, "", gameStyles.title.Render(m.Announcement))
+	}
+
 	if messageView != "" {
 		sections = append(sections, "", messageView)
 	}
 
-	helpText := "Use arrows to move • enter to select • q to quit"
+	helpText := "Use arrows to move • enter to select • m memorial • q to quit"
 	if hasEvent {
 		helpText = "[E] Respond to event • arrows to move • enter to select • q to quit"
 	}
+	if m.SubMenu != SubMenuNone {
+		helpText = "Use arrows to move • enter to choose • esc to go back"
+	}
 
 	sections = append(sections,
 		"",
@@ -102,6 +93,15 @@ func (m Model) View() string {
 	return lipgloss.JoinVertical(lipgloss.Left, sections...)
 }
 
+// renderTitle shows the pet's name between its form emoji, wearing any equipped cosmetic
+func (m Model) renderTitle() string {
+	if NoEmoji {
+		return gameStyles.title.Render(m.Pet.Name + " the " + m.Pet.GetFormName())
+	}
+	dressed := m.Pet.GetDressedEmoji()
+	return gameStyles.title.Render(dressed + " " + m.Pet.Name + " " + dressed)
+}
+
 func (m Model) renderStats() string {
 	mood := m.Pet.Mood
 	if mood == "" {
@@ -109,34 +109,42 @@ func (m Model) renderStats() string {
 	}
 	moodDisplay := strings.ToUpper(mood[:1]) + mood[1:]
 
-	chronoEmoji := pet.GetChronotypeEmoji(m.Pet.Chronotype)
-	chronoName := pet.GetChronotypeName(m.Pet.Chronotype)
-	wakeHour, sleepHour := pet.GetChronotypeSchedule(m.Pet.Chronotype)
-	chronoDisplay := fmt.Sprintf("%s %s (%d:00-%d:00)", chronoEmoji, chronoName, wakeHour, sleepHour)
+	chronoDisplay := pet.GetScheduleDisplay(m.Pet)
 
-	var traitNames []string
-	for _, trait := range m.Pet.Traits {
-		traitNames = append(traitNames, trait.Name)
-	}
-	traitDisplay := strings.Join(traitNames, ", ")
-	if traitDisplay == "" {
-		traitDisplay = "None"
+	traitDisplay := pet.GetTraitsDisplay(m.Pet)
+
+	forecast := pet.ForecastEvolution(m.Pet, pet.TimeNow())
+	care := forecast.Care
+	withAvg := func(value, avg int) string {
+		return fmt.Sprintf("%d%% (avg %d%%)", value, avg)
 	}
 
 	stats := []struct {
 		name, value string
 	}{
 		{"Form", m.Pet.GetFormName()},
+		{"Lineage", pet.GetLineageDisplay(m.Pet)},
+		{"Family", pet.GetFamilyDisplay(m.Pet)},
+		{"Next", forecast.ProjectedFormDisplay()},
+		{"Evolves", "in " + forecast.TimeUntilDisplay()},
+		{"Care", fmt.Sprintf("%d%%, %s lowest", care.OverallAverage(), forecast.WeakestStat.Name)},
 		{"Type", chronoDisplay},
+		{"Clock", pet.GetTimeZoneDisplay(m.Pet)},
 		{"Traits", traitDisplay},
+		{"Growing", pet.GetTraitDriftDisplay(m.Pet)},
 		{"Bond", pet.GetBondDescription(m.Pet.Bond)},
+		{"Tricks", pet.GetTricksDisplay(m.Pet)},
+		{"Quests", pet.GetQuestSummary(m.Pet)},
+		{"Coins", pet.GetCoinDisplay(pet.LoadWallet().Balance)},
 		{"Mood", moodDisplay},
-		{"Hunger", fmt.Sprintf("%d%%", m.Pet.Hunger)},
-		{"Happiness", fmt.Sprintf("%d%%", m.Pet.Happiness)},
-		{"Energy", fmt.Sprintf("%d%%", m.Pet.Energy)},
-		{"Health", fmt.Sprintf("%d%%", m.Pet.Health)},
+		{"Hunger", withAvg(m.Pet.Hunger, care.AvgHunger)},
+		{"Happiness", withAvg(m.Pet.Happiness, care.AvgHappiness)},
+		{"Energy", withAvg(m.Pet.Energy, care.AvgEnergy)},
+		{"Health", withAvg(m.Pet.Health, care.AvgHealth)},
+		{"Clean", withAvg(m.Pet.Cleanliness, care.AvgCleanliness)},
+		{"Weight", pet.GetWeightDisplay(m.Pet)},
 		{"Age", fmt.Sprintf("%dh", m.Pet.Age)},
-		{"Illness", map[bool]string{true: "Yes", false: "No"}[m.Pet.Illness]},
+		{"Illness", pet.GetIllnessDisplay(m.Pet)},
 	}
 
 	var lines []string
@@ -148,14 +156,33 @@ func (m Model) renderStats() string {
 }
 
 func (m Model) renderStatus() string {
-	return gameStyles.status.Render(fmt.Sprintf("Status: %s", pet.GetStatusWithLabel(m.Pet)))
+	status := fmt.Sprintf("Status: %s", pet.GetStatusWithLabel(m.Pet))
+	if mess := pet.GetMessDisplay(m.Pet); mess != "" {
+		status += "\nMess:   " + mess
+	}
+	return gameStyles.status.Render(status)
+}
+
+var menuOptions = []string{
+	"Feed",
+	"Play",
+	"Sleep",
+	"Vet",
+	"Clean",
+	"Train",
+	"Tricks",
+	"Shop",
+	"Quit",
 }
 
 func (m Model) renderMenu() string {
-	choices := []string{"Feed", "Play", "Sleep", "Medicine", "Quit"}
+	if m.SubMenu != SubMenuNone {
+		return m.renderSubMenu()
+	}
+
 	var menuItems []string
 
-	for i, choice := range choices {
+	for i, choice := range menuOptions {
 		cursor := " "
 		if m.Choice == i {
 			cursor = ">"
@@ -166,6 +193,44 @@ func (m Model) renderMenu() string {
 	return gameStyles.menuBox.Render(strings.Join(menuItems, "\n"))
 }
 
+func (m Model) renderSubMenu() string {
+	var labels []string
+	var header string
+	switch m.SubMenu {
+	case SubMenuFood:
+		header = "Choose a food:"
+		for _, food := range pet.GetFoodDefinitions() {
+			labels = append(labels, pet.GetFoodMenuLabel(m.Pet, food))
+		}
+	case SubMenuPlay:
+		header = "Choose a game:"
+		for _, activity := range pet.GetPlayActivityDefinitions() {
+			labels = append(labels, pet.GetPlayMenuLabel(m.Pet, activity))
+		}
+	case SubMenuVet:
+		header = "Visit the vet:"
+		labels = append(labels, "🩺 Checkup", "💊 Give Medicine")
+	case SubMenuShop:
+		wallet := pet.LoadWallet()
+		header = fmt.Sprintf("Shop (%s):", pet.GetCoinDisplay(wallet.Balance))
+		for _, item := range pet.GetShopItems() {
+			labels = append(labels, pet.GetShopMenuLabel(m.Pet, wallet, item))
+		}
+	}
+	labels = append(labels, "Back")
+
+	menuItems := []string{header}
+	for i, label := range labels {
+		cursor := " "
+		if m.SubMenuChoice == i {
+			cursor = ">"
+		}
+		menuItems = append(menuItems, fmt.Sprintf("%s %s", cursor, label))
+	}
+
+	return gameStyles.menuBox.Render(strings.Join(menuItems, "\n"))
+}
+
 var cheatMenuOptions = []string{
 	"Max All Stats",
 	"Min All Stats (Critical)",
@@ -187,10 +252,7 @@ var cheatMenuOptions = []string{
 
 func (m Model) renderCheatMenu() string {
 	var menuItems []string
-	header := lipgloss.NewStyle().
-		Bold(true).
-		Foreground(lipgloss.Color("#FF0000")).
-		Render("⚠️  CHEAT MENU ⚠️")
+	header := gameStyles.alert.Render("⚠️  CHEAT MENU ⚠️")
 
 	for i, choice := range cheatMenuOptions {
 		cursor := " "
@@ -217,6 +279,7 @@ func (m *Model) executeCheat() {
 			p.Happiness = pet.MaxStat
 			p.Energy = pet.MaxStat
 			p.Health = pet.MaxStat
+			p.Cleanliness = pet.MaxStat
 		})
 		m.setMessage("🎮 All stats maxed!")
 	case 1: // Min All Stats (Critical)
@@ -225,6 +288,7 @@ func (m *Model) executeCheat() {
 			p.Happiness = 10
 			p.Energy = 10
 			p.Health = 10
+			p.Cleanliness = 10
 		})
 		m.setMessage("🎮 Stats set to critical!")
 	case 2: // Full Energy
@@ -263,7 +327,11 @@ func (m *Model) executeCheat() {
 		m.setMessage("🎮 Mood set to needy")
 	case 8: // Toggle Illness
 		m.modifyStats(func(p *pet.Pet) {
-			p.Illness = !p.Illness
+			wasIll := p.Illness
+			p.CureDisease()
+			if !wasIll {
+				p.CatchDisease(pet.DiseaseFever)
+			}
 		})
 		if m.Pet.Illness {
 			m.setMessage("🎮 Illness: ON")
@@ -284,16 +352,19 @@ func (m *Model) executeCheat() {
 	case 10: // Type: Early Bird
 		m.modifyStats(func(p *pet.Pet) {
 			p.Chronotype = pet.ChronotypeEarlyBird
+			p.Schedule = nil
 		})
 		m.setMessage("🎮 Type: 🌅 Early Bird (5am-9pm)")
 	case 11: // Type: Normal
 		m.modifyStats(func(p *pet.Pet) {
 			p.Chronotype = pet.ChronotypeNormal
+			p.Schedule = nil
 		})
 		m.setMessage("🎮 Type: ☀️ Normal (7am-11pm)")
 	case 12: // Type: Night Owl
 		m.modifyStats(func(p *pet.Pet) {
 			p.Chronotype = pet.ChronotypeNightOwl
+			p.Schedule = nil
 		})
 		m.setMessage("🎮 Type: 🦉 Night Owl (10am-2am)")
 	case 13: // Age +24h
@@ -305,8 +376,7 @@ func (m *Model) executeCheat() {
 		m.setMessage(fmt.Sprintf("🎮 Age advanced! Now %dh", m.Pet.Age))
 	case 14: // Kill Pet
 		m.modifyStats(func(p *pet.Pet) {
-			p.Dead = true
-			p.CauseOfDeath = "Cheats"
+			p.Die(pet.CheatDeathCause, pet.TimeNow())
 		})
 		m.setMessage("🎮 Pet has been killed")
 		m.ShowingAdoptPrompt = true
@@ -317,13 +387,10 @@ func (m *Model) executeCheat() {
 
 func (m Model) renderAnimation() string {
 	frame := GetAnimationFrame(m.Animation)
-	formEmoji := m.Pet.GetFormEmoji()
-	title := gameStyles.title.Render(formEmoji + " " + m.Pet.Name + " " + formEmoji)
-
-	animStyle := lipgloss.NewStyle().
-		Foreground(lipgloss.Color("#FFD700")).
-		Bold(true).
-		Padding(1, 2)
+	if NoEmoji {
+		frame = GetSprite(m.Pet, m.Animation.Frame) + "\n\n  " + animationCaptions[m.Animation.Type]
+	}
+	title := m.renderTitle()
 
 	var status string
 	if m.Message != "" && pet.TimeNow().Before(m.MessageExpires) {
@@ -333,7 +400,11 @@ func (m Model) renderAnimation() string {
 	sections := []string{
 		title,
 		"",
-		animStyle.Render(frame),
+		gameStyles.animation.Render(frame),
+	}
+
+	if m.Animation.Type == AnimEvolve && m.EvolutionMessage != "" {
+		status = gameStyles.title.Render(m.EvolutionMessage)
 	}
 
 	if status != "" {
@@ -345,17 +416,23 @@ func (m Model) renderAnimation() string {
 
 func (m Model) deadView() string {
 	if m.ShowingAdoptPrompt {
+		lived := m.Pet.Age
+		if m.Pet.DiedAt != nil {
+			lived = m.Pet.AgeAtDeath
+		}
 		return lipgloss.JoinVertical(
 			lipgloss.Center,
 			gameStyles.title.Render("💀 "+m.Pet.Name+" 💀"),
 			"",
 			gameStyles.status.Render("Your pet has passed away..."),
 			gameStyles.status.Render("Cause of death: "+m.Pet.CauseOfDeath),
-			gameStyles.status.Render("They lived for "+fmt.Sprintf("%d hours", m.Pet.Age)),
+			gameStyles.status.Render("They lived for "+fmt.Sprintf("%d hours", lived)),
 			"",
 			gameStyles.menuBox.Render("Would you like to adopt a new pet?"),
 			"",
 			gameStyles.status.Render("Press 'y' for yes, 'n' for no"),
+			gameStyles.status.Render("Press 'h' to adopt "+m.Pet.Name+"'s heir"),
+			gameStyles.status.Render("Press 'm' to visit the memorial"),
 		)
 	}
 	return lipgloss.JoinVertical(
@@ -365,6 +442,6 @@ func (m Model) deadView() string {
 		gameStyles.status.Render("Your pet has passed away..."),
 		gameStyles.status.Render("It will be remembered forever."),
 		"",
-		gameStyles.status.Render("Press q to exit"),
+		gameStyles.status.Render("Press m for the memorial, q to exit"),
 	)
 }
@@ -0,0 +1,33 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"vpet/internal/pet"
+)
+
+// walletHistoryLines is how many recent transactions the CLI lists
+const walletHistoryLines = 10
+
+// FormatWallet renders the coin balance and recent transactions as plain text for the CLI, with times in the given zone
+func FormatWallet(w pet.Wallet, loc *time.Location) string {
+	var s strings.Builder
+	s.WriteString(fmt.Sprintf("%s Balance: %d coins\n", pet.CoinEmoji, w.Balance))
+	if len(w.Transactions) == 0 {
+		s.WriteString("\nNo transactions yet. Respond to events, finish quests or catch something in chase mode to earn coins.\n")
+		return s.String()
+	}
+
+	s.WriteString("\nRecent transactions:\n")
+	start := len(w.Transactions) - walletHistoryLines
+	if start < 0 {
+		start = 0
+	}
+	for i := len(w.Transactions) - 1; i >= start; i-- {
+		t := w.Transactions[i]
+		s.WriteString(fmt.Sprintf("  %s  %+5d  %-28s (balance %d)\n", t.Time.In(loc).Format("Jan 2 15:04"), t.Amount, t.Reason, t.Balance))
+	}
+	return s.String()
+}
@@ -0,0 +1,63 @@
+package ui
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"vpet/internal/pet"
+)
+
+func TestShopMenu(t *testing.T) {
+	pet.TestConfigPath = filepath.Join(t.TempDir(), "test-pet.json")
+	t.Cleanup(func() { pet.TestConfigPath = "" })
+	pet.SaveWallet(pet.Wallet{Balance: 100})
+
+	p := pet.NewPet(nil)
+	p.Traits = nil
+	m := Model{Pet: p, Choice: 7}
+
+	updated, _ := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.SubMenu != SubMenuShop {
+		t.Fatalf("Expected the shop to open, got sub-menu %d", m.SubMenu)
+	}
+	if view := m.View(); !strings.Contains(view, "Shop (🪙 100)") || !strings.Contains(view, "Crown") {
+		t.Errorf("Expected the shop to list items and the balance:\n%s", view)
+	}
+
+	for i, id := range m.subMenuOptions() {
+		if id == pet.ShopBow {
+			m.SubMenuChoice = i
+		}
+	}
+	updated, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = updated.(Model)
+	if m.Pet.Cosmetic != pet.ShopBow {
+		t.Error("Expected the bow to be bought and worn")
+	}
+	if m.SubMenu != SubMenuShop {
+		t.Error("Expected the shop to stay open after a purchase")
+	}
+	if want := 100 - pet.GetShopPrice(pet.ShopBow); pet.LoadWallet().Balance != want {
+		t.Errorf("Expected %d coins left, got %d", want, pet.LoadWallet().Balance)
+	}
+}
+
+func TestFormatWallet(t *testing.T) {
+	if output := FormatWallet(pet.Wallet{}, time.UTC); !strings.Contains(output, "No transactions yet") {
+		t.Errorf("Expected an empty wallet hint:\n%s", output)
+	}
+
+	w := pet.Wallet{Balance: 12, Transactions: []pet.Transaction{
+		{Time: pet.TimeNow(), Amount: 20, Reason: "Quest: Be There", Balance: 20},
+		{Time: pet.TimeNow(), Amount: -8, Reason: "Bought Treat", Balance: 12},
+	}}
+	output := FormatWallet(w, time.UTC)
+	if !strings.Contains(output, "Balance: 12") || strings.Index(output, "Bought Treat") > strings.Index(output, "Quest") {
+		t.Errorf("Expected the balance and newest transactions first:\n%s", output)
+	}
+}
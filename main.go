@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 
 	"vpet/internal/chase"
 	"vpet/internal/pet"
@@ -32,9 +33,39 @@ func main() {
 	statsFlag := flag.Bool("stats", false, "Display detailed pet statistics")
 	chaseFlag := flag.Bool("chase", false, "Watch your pet chase a butterfly")
 	chaseSeed := flag.Int64("chase-seed", 0, "Seed for chase mode RNG (0 = use current time)")
+	statusCosmetic := flag.Bool("status-cosmetic", false, "Include the equipped cosmetic in -status output")
+	noEmoji := flag.Bool("no-emoji", false, "Draw the pet as ASCII art instead of emoji in the TUI")
+	asciiFlag := flag.Bool("ascii", false, "Render all output as plain ASCII")
+	themeFlag := flag.String("theme", "", "Color theme: auto, dark, light, high-contrast, monochrome or a user theme")
 	flag.Parse()
 
+	// The ASCII profile is only used when asked for. Without a saved choice, a terminal that looks like it
+	// can't draw emoji only swaps the TUI over to sprites, leaving -status and commands alone.
+	settings := pet.LoadSettings()
+	profile := settings.Profile
+	if *asciiFlag {
+		profile = pet.ProfileASCII
+	}
+	if profile != "" {
+		if err := pet.SetProfile(profile); err != nil {
+			fmt.Println(err)
+			os.Exit(1)
+		}
+	}
+	ui.NoEmoji = *noEmoji || pet.IsASCIIProfile() || (profile == "" && !ui.TerminalSupportsEmoji())
+
+	if runCommand(flag.Args()) {
+		return
+	}
+
+	// The theme is only needed by the full-screen views, so the terminal isn't queried for -status and commands
+	themeName := settings.Theme
+	if *themeFlag != "" {
+		themeName = *themeFlag
+	}
+
 	if *statsFlag {
+		applyTheme(themeName)
 		p := pet.LoadState()
 		ui.DisplayStats(p)
 		return
@@ -42,6 +73,13 @@ func main() {
 
 	if *statusFlag {
 		p := pet.LoadState()
+		if *statusCosmetic && !p.Dead {
+			fmt.Print(p.GetCosmeticEmoji())
+		}
+		if !p.Dead && p.LoadQuietSchedule().IsQuiet(pet.TimeNow()) {
+			fmt.Print(pet.RenderText(pet.StatusEmojiQuiet))
+			return
+		}
 		fmt.Print(strings.Split(pet.GetStatus(p), " ")[0])
 		return
 	}
@@ -53,13 +91,30 @@ func main() {
 	}
 
 	if *chaseFlag {
+		applyTheme(themeName)
 		chase.Run(*chaseSeed)
 		return
 	}
 
+	applyTheme(themeName)
 	program := tea.NewProgram(ui.NewModel())
 	if _, err := program.Run(); err != nil {
 		log.Printf("Alas, there's been an error: %v", err)
 		os.Exit(1)
 	}
 }
+
+// applyTheme loads a theme, detecting a dark or light background for "auto", and styles every view with it
+func applyTheme(name string) {
+	dark := true
+	if name == "" || name == pet.ThemeAuto {
+		dark = lipgloss.HasDarkBackground()
+	}
+	theme, err := pet.LoadTheme(name, dark)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+	ui.ApplyTheme(theme)
+	chase.ApplyTheme(theme)
+}